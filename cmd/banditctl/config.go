@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	configEnv      = "BANDITCTL_CONFIG"
+	defaultProfile = "local"
+)
+
+// Config holds connection profiles, one per environment.
+type Config struct {
+	CurrentProfile string             `yaml:"current_profile"`
+	Profiles       map[string]Profile `yaml:"profiles"`
+}
+
+type Profile struct {
+	RuleAdmin     string        `yaml:"rule_admin"`
+	RuleDiller    string        `yaml:"rule_diller"`
+	BanditIndexer string        `yaml:"bandit_indexer"`
+	RuleTest      string        `yaml:"rule_test"`
+	APIKey        string        `yaml:"api_key"`
+	Timeout       time.Duration `yaml:"timeout"`
+}
+
+// localProfile matches ports published by infra/docker-compose.yml.
+var localProfile = Profile{
+	RuleAdmin:     "localhost:8444",
+	RuleDiller:    "localhost:8446",
+	BanditIndexer: "localhost:8448",
+	RuleTest:      "localhost:8441",
+	Timeout:       30 * time.Second,
+}
+
+func configPath(path string) string {
+	if len(path) > 0 {
+		return path
+	}
+	if env := os.Getenv(configEnv); len(env) > 0 {
+		return env
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".banditctl.yaml"
+	}
+	return filepath.Join(home, ".banditctl.yaml")
+}
+
+func readConfig(path string) (Config, error) {
+	cfg := Config{
+		CurrentProfile: defaultProfile,
+		Profiles:       map[string]Profile{defaultProfile: localProfile},
+	}
+
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return Config{}, errors.Wrap(err, "read config")
+	}
+
+	if err := yaml.Unmarshal(raw, &cfg); err != nil {
+		return Config{}, errors.Wrapf(err, "unmarshal config %s", path)
+	}
+
+	return cfg, nil
+}
+
+func writeConfig(path string, cfg Config) error {
+	raw, err := yaml.Marshal(cfg)
+	if err != nil {
+		return errors.Wrap(err, "marshal config")
+	}
+	return os.WriteFile(path, raw, 0o600)
+}
+
+func (c Config) profile(name string) (Profile, error) {
+	if len(name) == 0 {
+		name = c.CurrentProfile
+	}
+
+	p, ok := c.Profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("unknown profile %q", name)
+	}
+
+	if p.Timeout <= 0 {
+		p.Timeout = localProfile.Timeout
+	}
+	if key := os.Getenv("BANDITCTL_API_KEY"); len(key) > 0 {
+		p.APIKey = key
+	}
+
+	return p, nil
+}
+
+func (c Config) profileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
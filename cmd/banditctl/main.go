@@ -0,0 +1,206 @@
+// Command banditctl is a command-line client for bandit services.
+//
+//	banditctl [-profile name] [-config path] [-o table|json] <group> <command> [flags] [args]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/EbumbaE/bandit/pkg/auth"
+	indexer "github.com/EbumbaE/bandit/pkg/genproto/bandit-indexer/api"
+	admin "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
+	diller "github.com/EbumbaE/bandit/pkg/genproto/rule-diller/api"
+	ruletest "github.com/EbumbaE/bandit/pkg/genproto/rule-test/api"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, e *env, args []string) error
+}
+
+var groups = map[string][]command{
+	"rules":    rulesCommands,
+	"variants": variantsCommands,
+	"wanted":   wantedCommands,
+	"indexer":  indexerCommands,
+	"diller":   dillerCommands,
+	"loadtest": loadtestCommands,
+	"profile":  profileCommands,
+}
+
+// env is shared by commands: the selected profile, output and lazily dialed clients.
+type env struct {
+	cfg     Config
+	cfgPath string
+	profile Profile
+	out     printer
+
+	conns []*grpc.ClientConn
+}
+
+func main() {
+	profileName := flag.String("profile", "", "config profile, current_profile of the config when empty")
+	cfgPath := flag.String("config", "", "config path, $"+configEnv+" or ~/.banditctl.yaml when empty")
+	output := flag.String("o", outputTable, "output format: table or json")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := findCommand(flag.Arg(0), flag.Arg(1))
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", strings.Join(flag.Args()[:2], " "))
+		usage()
+		os.Exit(2)
+	}
+
+	if *output != outputTable && *output != outputJSON {
+		fatal(fmt.Errorf("unknown output format %q", *output))
+	}
+
+	e := &env{
+		cfgPath: configPath(*cfgPath),
+		out:     printer{w: os.Stdout, format: *output},
+	}
+
+	var err error
+	if e.cfg, err = readConfig(e.cfgPath); err != nil {
+		fatal(err)
+	}
+	// profile commands manage the config itself and don't need a valid current profile
+	if e.profile, err = e.cfg.profile(*profileName); err != nil && flag.Arg(0) != "profile" {
+		fatal(err)
+	}
+	defer e.close()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := cmd.run(ctx, e, flag.Args()[2:]); err != nil {
+		e.close()
+		fatal(err)
+	}
+}
+
+func findCommand(group, name string) (command, bool) {
+	for _, cmd := range groups[group] {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: banditctl [-profile name] [-config path] [-o table|json] <group> <command> [flags] [args]")
+	fmt.Fprintln(os.Stderr)
+
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, group := range names {
+		for _, cmd := range groups[group] {
+			fmt.Fprintf(os.Stderr, "  %s %s %s\n", group, cmd.name, cmd.usage)
+		}
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "banditctl:", err)
+	os.Exit(1)
+}
+
+func (e *env) dial(addr string) (*grpc.ClientConn, error) {
+	if len(addr) == 0 {
+		return nil, fmt.Errorf("address is not set in profile")
+	}
+
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(auth.NewAPIKeyCredentials(e.profile.APIKey)),
+		grpc.WithUnaryInterceptor(e.timeoutInterceptor),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	e.conns = append(e.conns, conn)
+	return conn, nil
+}
+
+// timeoutInterceptor bounds a call by the profile timeout unless the command set its own deadline.
+func (e *env) timeoutInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.profile.Timeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func (e *env) admin() (admin.RuleAdminServiceClient, error) {
+	conn, err := e.dial(e.profile.RuleAdmin)
+	if err != nil {
+		return nil, fmt.Errorf("rule-admin: %w", err)
+	}
+	return admin.NewRuleAdminServiceClient(conn), nil
+}
+
+func (e *env) indexer() (indexer.BanditIndexerServiceClient, error) {
+	conn, err := e.dial(e.profile.BanditIndexer)
+	if err != nil {
+		return nil, fmt.Errorf("bandit-indexer: %w", err)
+	}
+	return indexer.NewBanditIndexerServiceClient(conn), nil
+}
+
+func (e *env) diller() (diller.RuleDillerServiceClient, error) {
+	conn, err := e.dial(e.profile.RuleDiller)
+	if err != nil {
+		return nil, fmt.Errorf("rule-diller: %w", err)
+	}
+	return diller.NewRuleDillerServiceClient(conn), nil
+}
+
+func (e *env) ruleTest() (ruletest.RuleTestServiceClient, error) {
+	conn, err := e.dial(e.profile.RuleTest)
+	if err != nil {
+		return nil, fmt.Errorf("rule-test: %w", err)
+	}
+	return ruletest.NewRuleTestServiceClient(conn), nil
+}
+
+func (e *env) close() {
+	for _, conn := range e.conns {
+		_ = conn.Close()
+	}
+	e.conns = nil
+}
+
+// parseArgs parses flags of a command and checks the count of positional arguments.
+func parseArgs(fs *flag.FlagSet, args []string, names ...string) ([]string, error) {
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != len(names) {
+		return nil, fmt.Errorf("%s: expected arguments: %s", fs.Name(), strings.Join(names, " "))
+	}
+	return fs.Args(), nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+)
+
+var profileCommands = []command{
+	{name: "list", usage: "", run: listProfiles},
+	{name: "use", usage: "<profile>", run: useProfile},
+	{name: "set", usage: "[-rule-admin addr] [-rule-diller addr] [-bandit-indexer addr] [-rule-test addr] [-api-key key] [-timeout d] <profile>", run: setProfile},
+}
+
+func listProfiles(_ context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("profile list", flag.ContinueOnError)
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(e.cfg.Profiles))
+	for _, name := range e.cfg.profileNames() {
+		p := e.cfg.Profiles[name]
+		current := ""
+		if name == e.cfg.CurrentProfile {
+			current = "*"
+		}
+		rows = append(rows, []string{current, name, p.RuleAdmin, p.RuleDiller, p.BanditIndexer, p.RuleTest})
+	}
+
+	tw := printer{w: e.out.w, format: outputTable}
+	return tw.print(nil, []string{"CURRENT", "NAME", "RULE-ADMIN", "RULE-DILLER", "BANDIT-INDEXER", "RULE-TEST"}, rows)
+}
+
+func useProfile(_ context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("profile use", flag.ContinueOnError)
+	pos, err := parseArgs(fs, args, "<profile>")
+	if err != nil {
+		return err
+	}
+
+	if _, ok := e.cfg.Profiles[pos[0]]; !ok {
+		return fmt.Errorf("unknown profile %q", pos[0])
+	}
+
+	e.cfg.CurrentProfile = pos[0]
+	if err := writeConfig(e.cfgPath, e.cfg); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "switched to profile %s\n", pos[0])
+	return nil
+}
+
+func setProfile(_ context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("profile set", flag.ContinueOnError)
+	ruleAdmin := fs.String("rule-admin", "", "rule-admin grpc address")
+	ruleDiller := fs.String("rule-diller", "", "rule-diller grpc address")
+	banditIndexer := fs.String("bandit-indexer", "", "bandit-indexer grpc address")
+	ruleTest := fs.String("rule-test", "", "rule-test grpc address")
+	apiKey := fs.String("api-key", "", "rule-admin api key")
+	timeout := fs.Duration("timeout", 0, "request timeout")
+	pos, err := parseArgs(fs, args, "<profile>")
+	if err != nil {
+		return err
+	}
+
+	p, ok := e.cfg.Profiles[pos[0]]
+	if !ok {
+		p = localProfile
+	}
+
+	set := func(dst *string, v string) {
+		if len(v) > 0 {
+			*dst = v
+		}
+	}
+	set(&p.RuleAdmin, *ruleAdmin)
+	set(&p.RuleDiller, *ruleDiller)
+	set(&p.BanditIndexer, *banditIndexer)
+	set(&p.RuleTest, *ruleTest)
+	set(&p.APIKey, *apiKey)
+	if *timeout > 0 {
+		p.Timeout = *timeout
+	}
+
+	if e.cfg.Profiles == nil {
+		e.cfg.Profiles = map[string]Profile{}
+	}
+	e.cfg.Profiles[pos[0]] = p
+
+	if err := writeConfig(e.cfgPath, e.cfg); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "profile %s saved to %s\n", pos[0], e.cfgPath)
+	return nil
+}
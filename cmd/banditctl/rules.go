@@ -0,0 +1,260 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	admin "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
+)
+
+var rulesCommands = []command{
+	{name: "list", usage: "[-service s] [-context c] [-state enabled|disabled] [-name n] [-limit n] [-page token]", run: listRules},
+	{name: "get", usage: "<rule-id>", run: getRule},
+	{name: "create", usage: "-file rule.json (CreateRuleRequest in protojson)", run: createRule},
+	{name: "enable", usage: "<rule-id>", run: setRuleState(admin.State_STATE_ENABLED)},
+	{name: "disable", usage: "<rule-id>", run: setRuleState(admin.State_STATE_DISABLED)},
+	{name: "delete", usage: "<rule-id>", run: deleteRule},
+	{name: "migrate", usage: "-bandit key <rule-id>", run: migrateRule},
+	{name: "reset", usage: "[-variant id] <rule-id>", run: resetRule},
+}
+
+func listRules(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("rules list", flag.ContinueOnError)
+	service := fs.String("service", "", "filter by service")
+	ruleContext := fs.String("context", "", "filter by context")
+	state := fs.String("state", "", "filter by state: enabled or disabled")
+	name := fs.String("name", "", "filter by name substring")
+	limit := fs.Uint("limit", 50, "page size")
+	page := fs.String("page", "", "page token of the previous response")
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	req := &admin.ListRulesRequest{
+		Service:   *service,
+		Context:   *ruleContext,
+		Name:      *name,
+		PageSize:  uint32(*limit),
+		PageToken: *page,
+	}
+	if len(*state) > 0 {
+		s, err := parseState(*state)
+		if err != nil {
+			return err
+		}
+		req.State = s
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.ListRules(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(resp.GetRules()))
+	for _, r := range resp.GetRules() {
+		rows = append(rows, []string{
+			r.GetId(), r.GetName(), r.GetService(), r.GetContext(), stateLabel(r.GetState()),
+			r.GetBanditKey(), strconv.Itoa(int(r.GetPriority())), formatTime(r.GetUpdatedAt()),
+		})
+	}
+	if err := e.out.print(resp, []string{"ID", "NAME", "SERVICE", "CONTEXT", "STATE", "BANDIT", "PRIORITY", "UPDATED"}, rows); err != nil {
+		return err
+	}
+
+	if len(resp.GetNextPageToken()) > 0 && e.out.format == outputTable {
+		fmt.Fprintf(os.Stderr, "next page: -page %s\n", resp.GetNextPageToken())
+	}
+
+	return nil
+}
+
+func getRule(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("rules get", flag.ContinueOnError)
+	pos, err := parseArgs(fs, args, "<rule-id>")
+	if err != nil {
+		return err
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.GetRule(ctx, &admin.GetRuleRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+
+	return printRule(e, resp)
+}
+
+func createRule(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("rules create", flag.ContinueOnError)
+	file := fs.String("file", "", "json file with CreateRuleRequest")
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+	if len(*file) == 0 {
+		return fmt.Errorf("rules create: -file is required")
+	}
+
+	raw, err := os.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+
+	req := &admin.CreateRuleRequest{}
+	if err := protojson.Unmarshal(raw, req); err != nil {
+		return fmt.Errorf("parse %s: %w", *file, err)
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.CreateRule(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return printRule(e, resp)
+}
+
+func setRuleState(state admin.State) func(ctx context.Context, e *env, args []string) error {
+	return func(ctx context.Context, e *env, args []string) error {
+		fs := flag.NewFlagSet("rules "+stateLabel(state), flag.ContinueOnError)
+		pos, err := parseArgs(fs, args, "<rule-id>")
+		if err != nil {
+			return err
+		}
+
+		cl, err := e.admin()
+		if err != nil {
+			return err
+		}
+
+		if _, err := cl.SetRuleState(ctx, &admin.SetRuleStateRequest{Id: pos[0], State: state}); err != nil {
+			return err
+		}
+
+		return e.out.done("rule %s %s", pos[0], stateLabel(state))
+	}
+}
+
+func deleteRule(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("rules delete", flag.ContinueOnError)
+	pos, err := parseArgs(fs, args, "<rule-id>")
+	if err != nil {
+		return err
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	if _, err := cl.DeleteRule(ctx, &admin.GetRuleRequest{Id: pos[0]}); err != nil {
+		return err
+	}
+
+	return e.out.done("rule %s deleted", pos[0])
+}
+
+func migrateRule(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("rules migrate", flag.ContinueOnError)
+	banditKey := fs.String("bandit", "", "target bandit key")
+	pos, err := parseArgs(fs, args, "<rule-id>")
+	if err != nil {
+		return err
+	}
+	if len(*banditKey) == 0 {
+		return fmt.Errorf("rules migrate: -bandit is required")
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.MigrateRuleBandit(ctx, &admin.MigrateRuleBanditRequest{Id: pos[0], BanditKey: *banditKey})
+	if err != nil {
+		return err
+	}
+
+	return printRule(e, resp)
+}
+
+func resetRule(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("rules reset", flag.ContinueOnError)
+	variant := fs.String("variant", "", "variant id, all variants of the rule if empty")
+	pos, err := parseArgs(fs, args, "<rule-id>")
+	if err != nil {
+		return err
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	if _, err := cl.ResetStatistics(ctx, &admin.ResetStatisticsRequest{RuleId: pos[0], VariantId: *variant}); err != nil {
+		return err
+	}
+
+	if len(*variant) > 0 {
+		return e.out.done("statistics of variant %s reset", *variant)
+	}
+	return e.out.done("statistics of rule %s reset", pos[0])
+}
+
+func printRule(e *env, resp *admin.RuleResponse) error {
+	r := resp.GetRule()
+
+	rows := [][]string{
+		{"id", r.GetId()},
+		{"name", r.GetName()},
+		{"description", r.GetDescription()},
+		{"service", r.GetService()},
+		{"context", r.GetContext()},
+		{"state", stateLabel(r.GetState())},
+		{"bandit", r.GetBanditKey()},
+		{"priority", strconv.Itoa(int(r.GetPriority()))},
+		{"protected", strconv.FormatBool(r.GetProtected())},
+		{"layer", r.GetLayerId()},
+		{"start_at", formatTime(r.GetStartAt())},
+		{"end_at", formatTime(r.GetEndAt())},
+		{"created_at", formatTime(r.GetCreatedAt())},
+		{"updated_at", formatTime(r.GetUpdatedAt())},
+	}
+	for _, v := range r.GetVariants() {
+		rows = append(rows, []string{"variant", fmt.Sprintf("%s %s %s %s", v.GetId(), v.GetName(), stateLabel(v.GetState()), truncate(v.GetData(), 60))})
+	}
+
+	return e.out.print(resp, []string{"FIELD", "VALUE"}, rows)
+}
+
+func parseState(s string) (admin.State, error) {
+	switch s {
+	case "enabled":
+		return admin.State_STATE_ENABLED, nil
+	case "disabled":
+		return admin.State_STATE_DISABLED, nil
+	default:
+		return admin.State_STATE_UNSPECIFIED, fmt.Errorf("unknown state %q", s)
+	}
+}
+
+func stateLabel(s admin.State) string {
+	return enumLabel(s.String(), "STATE_")
+}
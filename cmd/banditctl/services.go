@@ -0,0 +1,607 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	indexer "github.com/EbumbaE/bandit/pkg/genproto/bandit-indexer/api"
+	admin "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
+	diller "github.com/EbumbaE/bandit/pkg/genproto/rule-diller/api"
+	ruletest "github.com/EbumbaE/bandit/pkg/genproto/rule-test/api"
+)
+
+var wantedCommands = []command{
+	{name: "list", usage: "", run: listWanted},
+	{name: "get", usage: "<bandit-key>", run: getWanted},
+	{name: "create", usage: "-key bandit-key -name name [-schema json] [-default json]", run: createWanted},
+	{name: "update", usage: "-name name [-schema json] [-default json] <bandit-key>", run: updateWanted},
+	{name: "deprecate", usage: "[-reason text] [-restore] <bandit-key>", run: deprecateWanted},
+	{name: "stale", usage: "", run: listStaleRules},
+}
+
+var indexerCommands = []command{
+	{name: "scores", usage: "<rule-id>", run: indexerScores},
+	{name: "snapshot", usage: "-file path [-page-size n] [rule-id ...]", run: indexerSnapshot},
+	{name: "restore", usage: "-file path [-batch n] [rule-id ...]", run: indexerRestore},
+	{name: "history", usage: "[-variant id] [-from time] [-to time] [-limit n] <rule-id>", run: indexerHistory},
+}
+
+var dillerCommands = []command{
+	{name: "statistic", usage: "-service s -context c", run: dillerStatistic},
+	{name: "data", usage: "-service s -context c [-unit id] [-attr key=value ...]", run: dillerData},
+}
+
+var loadtestCommands = []command{
+	{name: "load", usage: "[-parallel n] [-rps n] [-duration d]", run: loadTest},
+	{name: "efficiency", usage: "[-rps n] [-duration d]", run: efficiencyTest},
+}
+
+func listWanted(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("wanted list", flag.ContinueOnError)
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.GetWantedRegistry(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(resp.GetRegistry()))
+	for _, wb := range resp.GetRegistry() {
+		rows = append(rows, []string{wb.GetBanditKey(), wb.GetName(), deprecatedLabel(wb), truncate(wb.GetDefaultConfig(), 60)})
+	}
+
+	return e.out.print(resp, []string{"KEY", "NAME", "DEPRECATED", "DEFAULT CONFIG"}, rows)
+}
+
+func getWanted(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("wanted get", flag.ContinueOnError)
+	pos, err := parseArgs(fs, args, "<bandit-key>")
+	if err != nil {
+		return err
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.GetWantedBandit(ctx, &admin.GetWantedBanditRequest{BanditKey: pos[0]})
+	if err != nil {
+		return err
+	}
+
+	return printWanted(e, resp, resp.GetBandit())
+}
+
+func createWanted(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("wanted create", flag.ContinueOnError)
+	key := fs.String("key", "", "bandit key")
+	name := fs.String("name", "", "bandit name")
+	configSchema := fs.String("schema", "", "config json schema")
+	defaultConfig := fs.String("default", "", "default config json")
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+	if len(*key) == 0 || len(*name) == 0 {
+		return fmt.Errorf("wanted create: -key and -name are required")
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	if _, err := cl.CreateWantedBandit(ctx, &admin.CreateWantedBanditRequest{
+		Data: &admin.WantedBandit{BanditKey: *key, Name: *name, ConfigSchema: *configSchema, DefaultConfig: *defaultConfig},
+	}); err != nil {
+		return err
+	}
+
+	return e.out.done("wanted bandit %s created", *key)
+}
+
+func updateWanted(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("wanted update", flag.ContinueOnError)
+	name := fs.String("name", "", "bandit name")
+	configSchema := fs.String("schema", "", "config json schema, removed when empty")
+	defaultConfig := fs.String("default", "", "default config json, removed when empty")
+	pos, err := parseArgs(fs, args, "<bandit-key>")
+	if err != nil {
+		return err
+	}
+	if len(*name) == 0 {
+		return fmt.Errorf("wanted update: -name is required")
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.UpdateWantedBandit(ctx, &admin.UpdateWantedBanditRequest{
+		Data: &admin.WantedBandit{BanditKey: pos[0], Name: *name, ConfigSchema: *configSchema, DefaultConfig: *defaultConfig},
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWanted(e, resp, resp.GetBandit())
+}
+
+func deprecateWanted(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("wanted deprecate", flag.ContinueOnError)
+	reason := fs.String("reason", "", "deprecation reason")
+	restore := fs.Bool("restore", false, "allow new rules on the bandit key again")
+	pos, err := parseArgs(fs, args, "<bandit-key>")
+	if err != nil {
+		return err
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.DeprecateWantedBandit(ctx, &admin.DeprecateWantedBanditRequest{BanditKey: pos[0], Reason: *reason, Restore: *restore})
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(resp.GetAffectedRules()))
+	for _, r := range resp.GetAffectedRules() {
+		rows = append(rows, []string{r.GetId(), r.GetName(), r.GetService(), r.GetContext(), stateLabel(r.GetState())})
+	}
+	if *restore {
+		return e.out.done("wanted bandit %s restored", pos[0])
+	}
+
+	if e.out.format == outputTable {
+		fmt.Fprintf(os.Stderr, "wanted bandit %s deprecated, %d rules still use it\n", pos[0], len(rows))
+	}
+	return e.out.print(resp, []string{"ID", "NAME", "SERVICE", "CONTEXT", "STATE"}, rows)
+}
+
+func listStaleRules(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("wanted stale", flag.ContinueOnError)
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.ListStaleBanditRules(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(resp.GetRules()))
+	for _, sr := range resp.GetRules() {
+		r := sr.GetRule()
+		rows = append(rows, []string{
+			r.GetBanditKey(), enumLabel(sr.GetIssue().String(), "BANDIT_KEY_ISSUE_"),
+			r.GetId(), r.GetName(), r.GetService(), r.GetContext(), stateLabel(r.GetState()),
+		})
+	}
+
+	return e.out.print(resp, []string{"BANDIT", "ISSUE", "ID", "NAME", "SERVICE", "CONTEXT", "STATE"}, rows)
+}
+
+func printWanted(e *env, resp proto.Message, wb *admin.WantedBandit) error {
+	return e.out.print(resp, []string{"FIELD", "VALUE"}, [][]string{
+		{"key", wb.GetBanditKey()},
+		{"name", wb.GetName()},
+		{"deprecated", deprecatedLabel(wb)},
+		{"deprecated_at", formatTime(wb.GetDeprecatedAt())},
+		{"config_schema", wb.GetConfigSchema()},
+		{"default_config", wb.GetDefaultConfig()},
+		{"created_at", formatTime(wb.GetCreatedAt())},
+		{"updated_at", formatTime(wb.GetUpdatedAt())},
+	})
+}
+
+func deprecatedLabel(wb *admin.WantedBandit) string {
+	if !wb.GetDeprecated() {
+		return "no"
+	}
+	if len(wb.GetDeprecationReason()) > 0 {
+		return "yes: " + wb.GetDeprecationReason()
+	}
+	return "yes"
+}
+
+func indexerScores(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("indexer scores", flag.ContinueOnError)
+	pos, err := parseArgs(fs, args, "<rule-id>")
+	if err != nil {
+		return err
+	}
+
+	cl, err := e.indexer()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.GetRuleScores(ctx, &indexer.GetRuleScoresRequest{Id: pos[0]})
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(resp.GetVariants()))
+	for _, v := range resp.GetVariants() {
+		rows = append(rows, []string{
+			v.GetId(), strconv.FormatFloat(v.GetScore(), 'f', 6, 64), strconv.FormatUint(v.GetCount(), 10),
+			strconv.FormatUint(resp.GetVersion(), 10),
+		})
+	}
+
+	return e.out.print(resp, []string{"VARIANT", "SCORE", "COUNT", "VERSION"}, rows)
+}
+
+// snapshotFile is the layout of bandit-indexer snapshots. Bandits are kept raw,
+// pages are merged and restores are split without knowing their fields.
+type snapshotFile struct {
+	FormatVersion int               `json:"format_version"`
+	CreatedAt     time.Time         `json:"created_at"`
+	Bandits       []json.RawMessage `json:"bandits"`
+}
+
+func indexerSnapshot(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("indexer snapshot", flag.ContinueOnError)
+	file := fs.String("file", "", "file to write the snapshot to")
+	pageSize := fs.Uint("page-size", 0, "bandits per request, the indexer default if zero")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(*file) == 0 {
+		return fmt.Errorf("indexer snapshot: -file is required")
+	}
+
+	cl, err := e.indexer()
+	if err != nil {
+		return err
+	}
+
+	var (
+		snap          snapshotFile
+		bandits, arms uint64
+		token         string
+	)
+	for {
+		resp, err := cl.CreateSnapshot(ctx, &indexer.CreateSnapshotRequest{
+			RuleIds:   fs.Args(),
+			PageSize:  uint32(*pageSize),
+			PageToken: token,
+		})
+		if err != nil {
+			return err
+		}
+
+		var page snapshotFile
+		if err := json.Unmarshal(resp.GetData(), &page); err != nil {
+			return fmt.Errorf("indexer snapshot: %w", err)
+		}
+		if len(token) == 0 {
+			snap.FormatVersion, snap.CreatedAt = page.FormatVersion, page.CreatedAt
+		}
+		snap.Bandits = append(snap.Bandits, page.Bandits...)
+		bandits, arms = bandits+resp.GetBandits(), arms+resp.GetArms()
+
+		if token = resp.GetNextPageToken(); len(token) == 0 {
+			break
+		}
+	}
+
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(*file, data, 0o600); err != nil {
+		return err
+	}
+
+	return e.out.done("snapshot of %d bandits and %d arms at %s written to %s",
+		bandits, arms, snap.CreatedAt.Format(time.RFC3339), *file)
+}
+
+func indexerRestore(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("indexer restore", flag.ContinueOnError)
+	file := fs.String("file", "", "snapshot file")
+	batch := fs.Int("batch", 100, "bandits per request")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(*file) == 0 {
+		return fmt.Errorf("indexer restore: -file is required")
+	}
+	if *batch <= 0 {
+		return fmt.Errorf("indexer restore: -batch must be positive")
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+
+	var snap snapshotFile
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return fmt.Errorf("indexer restore: %w", err)
+	}
+	bandits, err := selectSnapshotBandits(snap.Bandits, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	cl, err := e.indexer()
+	if err != nil {
+		return err
+	}
+
+	resp := &indexer.RestoreSnapshotResponse{}
+	for len(bandits) > 0 {
+		n := min(*batch, len(bandits))
+		part := snap
+		part.Bandits, bandits = bandits[:n], bandits[n:]
+
+		data, err := json.Marshal(part)
+		if err != nil {
+			return err
+		}
+
+		restored, err := cl.RestoreSnapshot(ctx, &indexer.RestoreSnapshotRequest{Data: data})
+		if err != nil {
+			return err
+		}
+		resp.RuleIds = append(resp.RuleIds, restored.GetRuleIds()...)
+	}
+
+	rows := make([][]string, 0, len(resp.GetRuleIds()))
+	for _, id := range resp.GetRuleIds() {
+		rows = append(rows, []string{id})
+	}
+
+	return e.out.print(resp, []string{"RESTORED RULE"}, rows)
+}
+
+// selectSnapshotBandits keeps bandits of the listed rules, all for empty ruleIDs.
+func selectSnapshotBandits(bandits []json.RawMessage, ruleIDs []string) ([]json.RawMessage, error) {
+	if len(ruleIDs) == 0 {
+		return bandits, nil
+	}
+
+	byRule := make(map[string]json.RawMessage, len(bandits))
+	for _, raw := range bandits {
+		var b struct {
+			RuleID string `json:"rule_id"`
+		}
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return nil, fmt.Errorf("indexer restore: %w", err)
+		}
+		byRule[b.RuleID] = raw
+	}
+
+	res := make([]json.RawMessage, 0, len(ruleIDs))
+	for _, id := range ruleIDs {
+		raw, ok := byRule[id]
+		if !ok {
+			return nil, fmt.Errorf("indexer restore: rule %s is not in snapshot", id)
+		}
+		res = append(res, raw)
+	}
+
+	return res, nil
+}
+
+func indexerHistory(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("indexer history", flag.ContinueOnError)
+	variant := fs.String("variant", "", "variant id, all arms of the rule if empty")
+	from := fs.String("from", "", "start time in RFC3339, a day before -to by default")
+	to := fs.String("to", "", "end time in RFC3339, now by default")
+	limit := fs.Uint64("limit", 0, "max count of points")
+	pos, err := parseArgs(fs, args, "<rule-id>")
+	if err != nil {
+		return err
+	}
+
+	req := &indexer.GetArmHistoryRequest{RuleId: pos[0], VariantId: *variant, Limit: *limit}
+	if req.From, err = parseTime(*from); err != nil {
+		return fmt.Errorf("indexer history: -from: %w", err)
+	}
+	if req.To, err = parseTime(*to); err != nil {
+		return fmt.Errorf("indexer history: -to: %w", err)
+	}
+
+	cl, err := e.indexer()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.GetArmHistory(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(resp.GetPoints()))
+	for _, p := range resp.GetPoints() {
+		rows = append(rows, []string{
+			formatTime(p.GetRecordedAt()), p.GetVariantId(), strconv.FormatUint(p.GetBanditVersion(), 10),
+			strconv.FormatUint(p.GetCount(), 10), strconv.FormatFloat(p.GetMean(), 'f', 6, 64),
+			strconv.FormatFloat(p.GetVariance(), 'f', 6, 64),
+		})
+	}
+
+	return e.out.print(resp, []string{"TIME", "VARIANT", "VERSION", "COUNT", "MEAN", "VARIANCE"}, rows)
+}
+
+func parseTime(s string) (*timestamppb.Timestamp, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, err
+	}
+	return timestamppb.New(t), nil
+}
+
+func dillerRequest(fs *flag.FlagSet, args []string) (*diller.GetRuleRequest, error) {
+	service := fs.String("service", "", "service")
+	ruleContext := fs.String("context", "", "context")
+	unit := fs.String("unit", "", "unit id")
+	var attrs attributes
+	fs.Var(&attrs, "attr", "targeting attribute key=value, repeatable")
+	if _, err := parseArgs(fs, args); err != nil {
+		return nil, err
+	}
+	if len(*service) == 0 || len(*ruleContext) == 0 {
+		return nil, fmt.Errorf("%s: -service and -context are required", fs.Name())
+	}
+
+	return &diller.GetRuleRequest{
+		Service:    *service,
+		Context:    *ruleContext,
+		UnitId:     *unit,
+		Attributes: attrs,
+	}, nil
+}
+
+func dillerStatistic(ctx context.Context, e *env, args []string) error {
+	req, err := dillerRequest(flag.NewFlagSet("diller statistic", flag.ContinueOnError), args)
+	if err != nil {
+		return err
+	}
+
+	cl, err := e.diller()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.GetRuleStatistic(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(resp.GetScores()))
+	for _, s := range resp.GetScores() {
+		rows = append(rows, []string{s.GetVariantId(), strconv.FormatFloat(s.GetScore(), 'f', 6, 64)})
+	}
+
+	return e.out.print(resp, []string{"VARIANT", "SCORE"}, rows)
+}
+
+func dillerData(ctx context.Context, e *env, args []string) error {
+	req, err := dillerRequest(flag.NewFlagSet("diller data", flag.ContinueOnError), args)
+	if err != nil {
+		return err
+	}
+
+	cl, err := e.diller()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.GetRuleData(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(resp, []string{"DATA", "PAYLOAD"}, [][]string{{resp.GetData(), resp.GetPayload()}})
+}
+
+func loadTest(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("loadtest load", flag.ContinueOnError)
+	parallel := fs.Uint64("parallel", 10, "parallel workers")
+	rps := fs.Uint64("rps", 1000, "target rps")
+	duration := fs.Duration("duration", time.Minute, "test duration")
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	cl, err := e.ruleTest()
+	if err != nil {
+		return err
+	}
+
+	// the test runs synchronously for the whole duration
+	ctx, cancel := context.WithTimeout(ctx, *duration+e.profile.Timeout)
+	defer cancel()
+
+	if _, err := cl.DoLoadTest(ctx, &ruletest.LoadTestRequest{
+		ParallelCount: *parallel,
+		TargetRps:     *rps,
+		Duration:      durationpb.New(*duration),
+	}); err != nil {
+		return err
+	}
+
+	return e.out.done("load test finished: %d workers, %d rps, %s", *parallel, *rps, *duration)
+}
+
+func efficiencyTest(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("loadtest efficiency", flag.ContinueOnError)
+	rps := fs.Uint64("rps", 800, "target rps")
+	duration := fs.Duration("duration", 2*time.Minute, "test duration")
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	cl, err := e.ruleTest()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *duration+e.profile.Timeout)
+	defer cancel()
+
+	if _, err := cl.DoEfficiencyTest(ctx, &ruletest.EfficiencyTestRequest{
+		TargetRps: *rps,
+		Duration:  durationpb.New(*duration),
+	}); err != nil {
+		return err
+	}
+
+	return e.out.done("efficiency test finished: %d rps, %s", *rps, *duration)
+}
+
+type attributes map[string]string
+
+func (a *attributes) String() string {
+	pairs := make([]string, 0, len(*a))
+	for k, v := range *a {
+		pairs = append(pairs, k+"="+v)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (a *attributes) Set(s string) error {
+	k, v, ok := strings.Cut(s, "=")
+	if !ok || len(k) == 0 {
+		return fmt.Errorf("attribute %q is not key=value", s)
+	}
+	if *a == nil {
+		*a = attributes{}
+	}
+	(*a)[k] = v
+	return nil
+}
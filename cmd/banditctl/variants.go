@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	admin "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
+)
+
+var variantsCommands = []command{
+	{name: "get", usage: "<rule-id> <variant-id>", run: getVariant},
+	{name: "add", usage: "-name n -data json [-disabled] <rule-id>", run: addVariant},
+	{name: "update", usage: "[-name n] [-data json] <rule-id> <variant-id>", run: updateVariant},
+	{name: "enable", usage: "<rule-id> <variant-id>", run: setVariantState(admin.State_STATE_ENABLED)},
+	{name: "disable", usage: "<rule-id> <variant-id>", run: setVariantState(admin.State_STATE_DISABLED)},
+	{name: "delete", usage: "<rule-id> <variant-id>", run: deleteVariant},
+}
+
+func getVariant(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("variants get", flag.ContinueOnError)
+	pos, err := parseArgs(fs, args, "<rule-id>", "<variant-id>")
+	if err != nil {
+		return err
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.GetVariant(ctx, &admin.GetVariantRequest{RuleId: pos[0], Id: pos[1]})
+	if err != nil {
+		return err
+	}
+
+	return printVariant(e, resp)
+}
+
+func addVariant(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("variants add", flag.ContinueOnError)
+	name := fs.String("name", "", "variant name")
+	data := fs.String("data", "", "variant data json")
+	disabled := fs.Bool("disabled", false, "add the variant disabled")
+	pos, err := parseArgs(fs, args, "<rule-id>")
+	if err != nil {
+		return err
+	}
+	if len(*name) == 0 {
+		return fmt.Errorf("variants add: -name is required")
+	}
+
+	state := admin.State_STATE_ENABLED
+	if *disabled {
+		state = admin.State_STATE_DISABLED
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.AddVariant(ctx, &admin.AddVariantRequest{
+		RuleId:  pos[0],
+		Variant: &admin.Variant{Name: *name, Data: *data, State: state},
+	})
+	if err != nil {
+		return err
+	}
+
+	return printVariant(e, resp)
+}
+
+func updateVariant(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("variants update", flag.ContinueOnError)
+	name := fs.String("name", "", "new variant name")
+	data := fs.String("data", "", "new variant data json")
+	pos, err := parseArgs(fs, args, "<rule-id>", "<variant-id>")
+	if err != nil {
+		return err
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	resp, err := cl.UpdateVariant(ctx, &admin.UpdateVariantRequest{RuleId: pos[0], Id: pos[1], Name: *name, Data: *data})
+	if err != nil {
+		return err
+	}
+
+	return printVariant(e, resp)
+}
+
+func setVariantState(state admin.State) func(ctx context.Context, e *env, args []string) error {
+	return func(ctx context.Context, e *env, args []string) error {
+		fs := flag.NewFlagSet("variants "+stateLabel(state), flag.ContinueOnError)
+		pos, err := parseArgs(fs, args, "<rule-id>", "<variant-id>")
+		if err != nil {
+			return err
+		}
+
+		cl, err := e.admin()
+		if err != nil {
+			return err
+		}
+
+		if _, err := cl.SetVariantState(ctx, &admin.SetVariantStateRequest{RuleId: pos[0], Id: pos[1], State: state}); err != nil {
+			return err
+		}
+
+		return e.out.done("variant %s %s", pos[1], stateLabel(state))
+	}
+}
+
+func deleteVariant(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("variants delete", flag.ContinueOnError)
+	pos, err := parseArgs(fs, args, "<rule-id>", "<variant-id>")
+	if err != nil {
+		return err
+	}
+
+	cl, err := e.admin()
+	if err != nil {
+		return err
+	}
+
+	if _, err := cl.DeleteVariant(ctx, &admin.RemoveVariantRequest{RuleId: pos[0], Id: pos[1]}); err != nil {
+		return err
+	}
+
+	return e.out.done("variant %s deleted", pos[1])
+}
+
+func printVariant(e *env, resp *admin.VariantResponse) error {
+	v := resp.GetVariant()
+
+	return e.out.print(resp, []string{"ID", "NAME", "STATE", "START", "END", "DATA"}, [][]string{{
+		v.GetId(), v.GetName(), stateLabel(v.GetState()), formatTime(v.GetStartAt()), formatTime(v.GetEndAt()), v.GetData(),
+	}})
+}
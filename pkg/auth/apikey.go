@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const APIKeyMetadataKey = "x-api-key"
+
+type APIKey struct {
+	Key     string `yaml:"key"`
+	Subject string `yaml:"subject"`
+	Role    Role   `yaml:"role"`
+	Tenant  string `yaml:"tenant"`
+}
+
+type APIKeyAuthenticator struct {
+	keys []APIKey
+}
+
+func NewAPIKeyAuthenticator(keys []APIKey) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{
+		keys: keys,
+	}
+}
+
+func (a *APIKeyAuthenticator) Authenticate(_ context.Context, md metadata.MD) (Principal, error) {
+	values := md.Get(APIKeyMetadataKey)
+	if len(values) == 0 || len(values[0]) == 0 {
+		return Principal{}, ErrNoCredentials
+	}
+
+	for _, k := range a.keys {
+		if subtle.ConstantTimeCompare([]byte(k.Key), []byte(values[0])) == 1 {
+			return Principal{Subject: k.Subject, Role: k.Role, Tenant: k.Tenant}, nil
+		}
+	}
+
+	return Principal{}, ErrInvalidCredentials
+}
+
+type APIKeyCredentials struct {
+	key string
+}
+
+func NewAPIKeyCredentials(key string) *APIKeyCredentials {
+	return &APIKeyCredentials{
+		key: key,
+	}
+}
+
+func (c *APIKeyCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	if len(c.key) == 0 {
+		return nil, nil
+	}
+	return map[string]string{APIKeyMetadataKey: c.key}, nil
+}
+
+func (c *APIKeyCredentials) RequireTransportSecurity() bool {
+	return false
+}
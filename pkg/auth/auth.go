@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	ErrNoCredentials      = errors.New("no credentials")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+type Role string
+
+var (
+	RoleViewer Role = "viewer"
+	RoleEditor Role = "editor"
+	RoleAdmin  Role = "admin"
+)
+
+var roleRanks = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
+
+func (r Role) Valid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+func (r Role) Allows(required Role) bool {
+	return r.Valid() && roleRanks[r] >= roleRanks[required]
+}
+
+type Principal struct {
+	Subject string
+	Role    Role
+	Tenant  string
+}
+
+type Authenticator interface {
+	Authenticate(ctx context.Context, md metadata.MD) (Principal, error)
+}
+
+type Chain []Authenticator
+
+func (c Chain) Authenticate(ctx context.Context, md metadata.MD) (Principal, error) {
+	for _, a := range c {
+		p, err := a.Authenticate(ctx, md)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		return p, err
+	}
+	return Principal{}, ErrNoCredentials
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// UnknownActor is the actor of calls without an authenticated principal.
+const UnknownActor = "unknown"
+
+// ActorFromContext returns the authenticated subject. Client supplied identities
+// are not trusted, calls without a principal are attributed to UnknownActor.
+func ActorFromContext(ctx context.Context) string {
+	if p, ok := PrincipalFromContext(ctx); ok && len(p.Subject) > 0 {
+		return p.Subject
+	}
+	return UnknownActor
+}
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func UnaryServerInterceptor(authn Authenticator, methodRoles map[string]Role) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		p, err := authn.Authenticate(ctx, md)
+		if err != nil {
+			if errors.Is(err, ErrNoCredentials) || errors.Is(err, ErrInvalidCredentials) {
+				return nil, status.Error(codes.Unauthenticated, err.Error())
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		required, ok := methodRoles[info.FullMethod]
+		if !ok {
+			required = RoleAdmin
+		}
+		if !p.Role.Allows(required) {
+			return nil, status.Errorf(codes.PermissionDenied, "role %s is not allowed to call %s", p.Role, info.FullMethod)
+		}
+
+		return handler(WithPrincipal(ctx, p), req)
+	}
+}
+
+func GatewayHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, APIKeyMetadataKey) {
+		return APIKeyMetadataKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+	bearerPrefix             = "bearer "
+	defaultRoleClaim         = "role"
+	defaultTenantClaim       = "tenant"
+)
+
+type JWTConfig struct {
+	JWKSFile    string `yaml:"jwks_file"`
+	Issuer      string `yaml:"issuer"`
+	Audience    string `yaml:"audience"`
+	RoleClaim   string `yaml:"role_claim"`
+	TenantClaim string `yaml:"tenant_claim"`
+}
+
+type JWTAuthenticator struct {
+	keys        map[string]any
+	parser      *jwt.Parser
+	roleClaim   string
+	tenantClaim string
+}
+
+func NewJWTAuthenticator(cfg JWTConfig) (*JWTAuthenticator, error) {
+	keys, err := loadJWKS(cfg.JWKSFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "load jwks")
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithExpirationRequired(),
+	}
+	if len(cfg.Issuer) > 0 {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if len(cfg.Audience) > 0 {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	roleClaim := cfg.RoleClaim
+	if len(roleClaim) == 0 {
+		roleClaim = defaultRoleClaim
+	}
+
+	tenantClaim := cfg.TenantClaim
+	if len(tenantClaim) == 0 {
+		tenantClaim = defaultTenantClaim
+	}
+
+	return &JWTAuthenticator{
+		keys:        keys,
+		parser:      jwt.NewParser(opts...),
+		roleClaim:   roleClaim,
+		tenantClaim: tenantClaim,
+	}, nil
+}
+
+func (a *JWTAuthenticator) Authenticate(_ context.Context, md metadata.MD) (Principal, error) {
+	values := md.Get(authorizationMetadataKey)
+	if len(values) == 0 || !strings.HasPrefix(strings.ToLower(values[0]), bearerPrefix) {
+		return Principal{}, ErrNoCredentials
+	}
+	raw := strings.TrimSpace(values[0][len(bearerPrefix):])
+
+	claims := jwt.MapClaims{}
+	if _, err := a.parser.ParseWithClaims(raw, claims, a.keyFunc); err != nil {
+		return Principal{}, errors.Wrap(ErrInvalidCredentials, err.Error())
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || len(subject) == 0 {
+		return Principal{}, errors.Wrap(ErrInvalidCredentials, "empty subject")
+	}
+
+	role, _ := claims[a.roleClaim].(string)
+	if !Role(role).Valid() {
+		return Principal{}, errors.Wrapf(ErrInvalidCredentials, "invalid role %q", role)
+	}
+
+	tenant, _ := claims[a.tenantClaim].(string)
+
+	return Principal{Subject: subject, Role: Role(role), Tenant: tenant}, nil
+}
+
+func (a *JWTAuthenticator) keyFunc(t *jwt.Token) (any, error) {
+	kid, _ := t.Header["kid"].(string)
+	key, ok := a.keys[kid]
+	if !ok {
+		return nil, errors.Errorf("unknown key id %q", kid)
+	}
+	return key, nil
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func loadJWKS(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, errors.Wrap(err, "unmarshal jwks")
+	}
+
+	keys := make(map[string]any, len(set.Keys))
+	for _, k := range set.Keys {
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse key %q", k.Kid)
+		}
+		keys[k.Kid] = key
+	}
+
+	return keys, nil
+}
+
+func (k jwk) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(v string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(v)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,84 @@
+package clickhouse
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/EbumbaE/bandit/pkg/migration"
+)
+
+const SchemaVersionTable = "schema_version"
+
+// MigrationDriver executes migrations statement by statement. ClickHouse has no
+// transactions, so a failed migration is retried from its first statement and
+// scripts must be idempotent. It has no locks either: runners are serialized by locker.
+type MigrationDriver struct {
+	db     Database
+	table  string
+	locker migration.Locker
+}
+
+func NewMigrationDriver(db Database, table string, locker migration.Locker) *MigrationDriver {
+	return &MigrationDriver{
+		db:     db,
+		table:  table,
+		locker: locker,
+	}
+}
+
+func NewMigrator(db Database, locker migration.Locker, migrations []migration.Migration) *migration.Migrator {
+	return migration.New(NewMigrationDriver(db, SchemaVersionTable, locker), migrations)
+}
+
+func (d *MigrationDriver) Lock(ctx context.Context, exec func(ctx context.Context) error) error {
+	return d.locker.Lock(ctx, exec)
+}
+
+func (d *MigrationDriver) Applied(ctx context.Context) ([]int, error) {
+	query := `
+		CREATE TABLE IF NOT EXISTS ` + d.table + ` (
+			version    UInt32,
+			name       String,
+			applied_at DateTime DEFAULT now()
+		) ENGINE = MergeTree()
+		ORDER BY version;
+`
+	if _, err := d.db.Exec(ctx, query); err != nil {
+		return nil, errors.Wrap(err, "create version table")
+	}
+
+	var versions []int
+	err := d.db.GetSlice(ctx, &versions, `SELECT toInt64(version) FROM `+d.table+` ORDER BY version`)
+
+	return versions, err
+}
+
+func (d *MigrationDriver) Apply(ctx context.Context, m migration.Migration) error {
+	if err := d.exec(ctx, m.Up); err != nil {
+		return err
+	}
+
+	_, err := d.db.Exec(ctx, `INSERT INTO `+d.table+` (version, name) VALUES (?, ?)`, uint32(m.Version), m.Name)
+
+	return err
+}
+
+func (d *MigrationDriver) Revert(ctx context.Context, m migration.Migration) error {
+	if err := d.exec(ctx, m.Down); err != nil {
+		return err
+	}
+
+	_, err := d.db.Exec(ctx, `DELETE FROM `+d.table+` WHERE version = ?`, uint32(m.Version))
+
+	return err
+}
+
+func (d *MigrationDriver) exec(ctx context.Context, script string) error {
+	for _, stmt := range migration.Statements(script) {
+		if _, err := d.db.Exec(ctx, stmt); err != nil {
+			return errors.Wrapf(err, "exec %q", stmt)
+		}
+	}
+	return nil
+}
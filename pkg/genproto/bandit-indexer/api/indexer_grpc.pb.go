@@ -19,7 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	BanditIndexerService_GetRuleScores_FullMethodName = "/bandit.services.banditindexer.BanditIndexerService/GetRuleScores"
+	BanditIndexerService_GetRuleScores_FullMethodName   = "/bandit.services.banditindexer.BanditIndexerService/GetRuleScores"
+	BanditIndexerService_CreateSnapshot_FullMethodName  = "/bandit.services.banditindexer.BanditIndexerService/CreateSnapshot"
+	BanditIndexerService_RestoreSnapshot_FullMethodName = "/bandit.services.banditindexer.BanditIndexerService/RestoreSnapshot"
+	BanditIndexerService_GetArmHistory_FullMethodName   = "/bandit.services.banditindexer.BanditIndexerService/GetArmHistory"
+	BanditIndexerService_ResetRule_FullMethodName       = "/bandit.services.banditindexer.BanditIndexerService/ResetRule"
+	BanditIndexerService_ResetArm_FullMethodName        = "/bandit.services.banditindexer.BanditIndexerService/ResetArm"
 )
 
 // BanditIndexerServiceClient is the client API for BanditIndexerService service.
@@ -27,6 +32,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BanditIndexerServiceClient interface {
 	GetRuleScores(ctx context.Context, in *GetRuleScoresRequest, opts ...grpc.CallOption) (*GetRuleScoresResponse, error)
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*Snapshot, error)
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
+	GetArmHistory(ctx context.Context, in *GetArmHistoryRequest, opts ...grpc.CallOption) (*GetArmHistoryResponse, error)
+	ResetRule(ctx context.Context, in *ResetRuleRequest, opts ...grpc.CallOption) (*ResetResponse, error)
+	ResetArm(ctx context.Context, in *ResetArmRequest, opts ...grpc.CallOption) (*ResetResponse, error)
 }
 
 type banditIndexerServiceClient struct {
@@ -46,11 +56,61 @@ func (c *banditIndexerServiceClient) GetRuleScores(ctx context.Context, in *GetR
 	return out, nil
 }
 
+func (c *banditIndexerServiceClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*Snapshot, error) {
+	out := new(Snapshot)
+	err := c.cc.Invoke(ctx, BanditIndexerService_CreateSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *banditIndexerServiceClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, BanditIndexerService_RestoreSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *banditIndexerServiceClient) GetArmHistory(ctx context.Context, in *GetArmHistoryRequest, opts ...grpc.CallOption) (*GetArmHistoryResponse, error) {
+	out := new(GetArmHistoryResponse)
+	err := c.cc.Invoke(ctx, BanditIndexerService_GetArmHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *banditIndexerServiceClient) ResetRule(ctx context.Context, in *ResetRuleRequest, opts ...grpc.CallOption) (*ResetResponse, error) {
+	out := new(ResetResponse)
+	err := c.cc.Invoke(ctx, BanditIndexerService_ResetRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *banditIndexerServiceClient) ResetArm(ctx context.Context, in *ResetArmRequest, opts ...grpc.CallOption) (*ResetResponse, error) {
+	out := new(ResetResponse)
+	err := c.cc.Invoke(ctx, BanditIndexerService_ResetArm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BanditIndexerServiceServer is the server API for BanditIndexerService service.
 // All implementations must embed UnimplementedBanditIndexerServiceServer
 // for forward compatibility
 type BanditIndexerServiceServer interface {
 	GetRuleScores(context.Context, *GetRuleScoresRequest) (*GetRuleScoresResponse, error)
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*Snapshot, error)
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
+	GetArmHistory(context.Context, *GetArmHistoryRequest) (*GetArmHistoryResponse, error)
+	ResetRule(context.Context, *ResetRuleRequest) (*ResetResponse, error)
+	ResetArm(context.Context, *ResetArmRequest) (*ResetResponse, error)
 	mustEmbedUnimplementedBanditIndexerServiceServer()
 }
 
@@ -61,6 +121,21 @@ type UnimplementedBanditIndexerServiceServer struct {
 func (UnimplementedBanditIndexerServiceServer) GetRuleScores(context.Context, *GetRuleScoresRequest) (*GetRuleScoresResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRuleScores not implemented")
 }
+func (UnimplementedBanditIndexerServiceServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*Snapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (UnimplementedBanditIndexerServiceServer) RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (UnimplementedBanditIndexerServiceServer) GetArmHistory(context.Context, *GetArmHistoryRequest) (*GetArmHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArmHistory not implemented")
+}
+func (UnimplementedBanditIndexerServiceServer) ResetRule(context.Context, *ResetRuleRequest) (*ResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetRule not implemented")
+}
+func (UnimplementedBanditIndexerServiceServer) ResetArm(context.Context, *ResetArmRequest) (*ResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetArm not implemented")
+}
 func (UnimplementedBanditIndexerServiceServer) mustEmbedUnimplementedBanditIndexerServiceServer() {}
 
 // UnsafeBanditIndexerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +167,96 @@ func _BanditIndexerService_GetRuleScores_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BanditIndexerService_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BanditIndexerServiceServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BanditIndexerService_CreateSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BanditIndexerServiceServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BanditIndexerService_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BanditIndexerServiceServer).RestoreSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BanditIndexerService_RestoreSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BanditIndexerServiceServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BanditIndexerService_GetArmHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArmHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BanditIndexerServiceServer).GetArmHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BanditIndexerService_GetArmHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BanditIndexerServiceServer).GetArmHistory(ctx, req.(*GetArmHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BanditIndexerService_ResetRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BanditIndexerServiceServer).ResetRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BanditIndexerService_ResetRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BanditIndexerServiceServer).ResetRule(ctx, req.(*ResetRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BanditIndexerService_ResetArm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetArmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BanditIndexerServiceServer).ResetArm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BanditIndexerService_ResetArm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BanditIndexerServiceServer).ResetArm(ctx, req.(*ResetArmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BanditIndexerService_ServiceDesc is the grpc.ServiceDesc for BanditIndexerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +268,26 @@ var BanditIndexerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRuleScores",
 			Handler:    _BanditIndexerService_GetRuleScores_Handler,
 		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _BanditIndexerService_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _BanditIndexerService_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "GetArmHistory",
+			Handler:    _BanditIndexerService_GetArmHistory_Handler,
+		},
+		{
+			MethodName: "ResetRule",
+			Handler:    _BanditIndexerService_ResetRule_Handler,
+		},
+		{
+			MethodName: "ResetArm",
+			Handler:    _BanditIndexerService_ResetArm_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bandit-indexer/api/indexer.proto",
@@ -20,19 +20,66 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	RuleAdminService_GetRule_FullMethodName               = "/bandit.services.ruleadmin.RuleAdminService/GetRule"
-	RuleAdminService_CheckRule_FullMethodName             = "/bandit.services.ruleadmin.RuleAdminService/CheckRule"
-	RuleAdminService_CreateRule_FullMethodName            = "/bandit.services.ruleadmin.RuleAdminService/CreateRule"
-	RuleAdminService_UpdateRule_FullMethodName            = "/bandit.services.ruleadmin.RuleAdminService/UpdateRule"
-	RuleAdminService_SetRuleState_FullMethodName          = "/bandit.services.ruleadmin.RuleAdminService/SetRuleState"
-	RuleAdminService_GetRuleServiceContext_FullMethodName = "/bandit.services.ruleadmin.RuleAdminService/GetRuleServiceContext"
-	RuleAdminService_GetVariant_FullMethodName            = "/bandit.services.ruleadmin.RuleAdminService/GetVariant"
-	RuleAdminService_CheckVariant_FullMethodName          = "/bandit.services.ruleadmin.RuleAdminService/CheckVariant"
-	RuleAdminService_GetVariantData_FullMethodName        = "/bandit.services.ruleadmin.RuleAdminService/GetVariantData"
-	RuleAdminService_AddVariant_FullMethodName            = "/bandit.services.ruleadmin.RuleAdminService/AddVariant"
-	RuleAdminService_SetVariantState_FullMethodName       = "/bandit.services.ruleadmin.RuleAdminService/SetVariantState"
-	RuleAdminService_CreateWantedBandit_FullMethodName    = "/bandit.services.ruleadmin.RuleAdminService/CreateWantedBandit"
-	RuleAdminService_GetWantedRegistry_FullMethodName     = "/bandit.services.ruleadmin.RuleAdminService/GetWantedRegistry"
+	RuleAdminService_GetRule_FullMethodName                 = "/bandit.services.ruleadmin.RuleAdminService/GetRule"
+	RuleAdminService_ListRules_FullMethodName               = "/bandit.services.ruleadmin.RuleAdminService/ListRules"
+	RuleAdminService_CheckRule_FullMethodName               = "/bandit.services.ruleadmin.RuleAdminService/CheckRule"
+	RuleAdminService_CreateRule_FullMethodName              = "/bandit.services.ruleadmin.RuleAdminService/CreateRule"
+	RuleAdminService_UpdateRule_FullMethodName              = "/bandit.services.ruleadmin.RuleAdminService/UpdateRule"
+	RuleAdminService_SetRuleState_FullMethodName            = "/bandit.services.ruleadmin.RuleAdminService/SetRuleState"
+	RuleAdminService_DeleteRule_FullMethodName              = "/bandit.services.ruleadmin.RuleAdminService/DeleteRule"
+	RuleAdminService_GetRuleServiceContext_FullMethodName   = "/bandit.services.ruleadmin.RuleAdminService/GetRuleServiceContext"
+	RuleAdminService_SetRuleSchedule_FullMethodName         = "/bandit.services.ruleadmin.RuleAdminService/SetRuleSchedule"
+	RuleAdminService_SetRuleVariantSchema_FullMethodName    = "/bandit.services.ruleadmin.RuleAdminService/SetRuleVariantSchema"
+	RuleAdminService_SetRuleBanditConfig_FullMethodName     = "/bandit.services.ruleadmin.RuleAdminService/SetRuleBanditConfig"
+	RuleAdminService_SetRuleTargeting_FullMethodName        = "/bandit.services.ruleadmin.RuleAdminService/SetRuleTargeting"
+	RuleAdminService_SetRuleLayer_FullMethodName            = "/bandit.services.ruleadmin.RuleAdminService/SetRuleLayer"
+	RuleAdminService_ListRuleRevisions_FullMethodName       = "/bandit.services.ruleadmin.RuleAdminService/ListRuleRevisions"
+	RuleAdminService_GetRuleRevision_FullMethodName         = "/bandit.services.ruleadmin.RuleAdminService/GetRuleRevision"
+	RuleAdminService_DiffRuleRevisions_FullMethodName       = "/bandit.services.ruleadmin.RuleAdminService/DiffRuleRevisions"
+	RuleAdminService_RollbackRule_FullMethodName            = "/bandit.services.ruleadmin.RuleAdminService/RollbackRule"
+	RuleAdminService_SetRuleProtected_FullMethodName        = "/bandit.services.ruleadmin.RuleAdminService/SetRuleProtected"
+	RuleAdminService_MigrateRuleBandit_FullMethodName       = "/bandit.services.ruleadmin.RuleAdminService/MigrateRuleBandit"
+	RuleAdminService_ResetStatistics_FullMethodName         = "/bandit.services.ruleadmin.RuleAdminService/ResetStatistics"
+	RuleAdminService_CloneRule_FullMethodName               = "/bandit.services.ruleadmin.RuleAdminService/CloneRule"
+	RuleAdminService_CreateRuleTemplate_FullMethodName      = "/bandit.services.ruleadmin.RuleAdminService/CreateRuleTemplate"
+	RuleAdminService_GetRuleTemplate_FullMethodName         = "/bandit.services.ruleadmin.RuleAdminService/GetRuleTemplate"
+	RuleAdminService_ListRuleTemplates_FullMethodName       = "/bandit.services.ruleadmin.RuleAdminService/ListRuleTemplates"
+	RuleAdminService_DeleteRuleTemplate_FullMethodName      = "/bandit.services.ruleadmin.RuleAdminService/DeleteRuleTemplate"
+	RuleAdminService_InstantiateRuleTemplate_FullMethodName = "/bandit.services.ruleadmin.RuleAdminService/InstantiateRuleTemplate"
+	RuleAdminService_ExportRules_FullMethodName             = "/bandit.services.ruleadmin.RuleAdminService/ExportRules"
+	RuleAdminService_ImportRules_FullMethodName             = "/bandit.services.ruleadmin.RuleAdminService/ImportRules"
+	RuleAdminService_CreateLayer_FullMethodName             = "/bandit.services.ruleadmin.RuleAdminService/CreateLayer"
+	RuleAdminService_GetLayer_FullMethodName                = "/bandit.services.ruleadmin.RuleAdminService/GetLayer"
+	RuleAdminService_GetVariant_FullMethodName              = "/bandit.services.ruleadmin.RuleAdminService/GetVariant"
+	RuleAdminService_CheckVariant_FullMethodName            = "/bandit.services.ruleadmin.RuleAdminService/CheckVariant"
+	RuleAdminService_GetVariantData_FullMethodName          = "/bandit.services.ruleadmin.RuleAdminService/GetVariantData"
+	RuleAdminService_AddVariant_FullMethodName              = "/bandit.services.ruleadmin.RuleAdminService/AddVariant"
+	RuleAdminService_UpdateVariant_FullMethodName           = "/bandit.services.ruleadmin.RuleAdminService/UpdateVariant"
+	RuleAdminService_DeleteVariant_FullMethodName           = "/bandit.services.ruleadmin.RuleAdminService/DeleteVariant"
+	RuleAdminService_SetVariantState_FullMethodName         = "/bandit.services.ruleadmin.RuleAdminService/SetVariantState"
+	RuleAdminService_SetVariantSchedule_FullMethodName      = "/bandit.services.ruleadmin.RuleAdminService/SetVariantSchedule"
+	RuleAdminService_AddGuardrail_FullMethodName            = "/bandit.services.ruleadmin.RuleAdminService/AddGuardrail"
+	RuleAdminService_RemoveGuardrail_FullMethodName         = "/bandit.services.ruleadmin.RuleAdminService/RemoveGuardrail"
+	RuleAdminService_GetRuleGuardrails_FullMethodName       = "/bandit.services.ruleadmin.RuleAdminService/GetRuleGuardrails"
+	RuleAdminService_ListGuardrails_FullMethodName          = "/bandit.services.ruleadmin.RuleAdminService/ListGuardrails"
+	RuleAdminService_CreateWantedBandit_FullMethodName      = "/bandit.services.ruleadmin.RuleAdminService/CreateWantedBandit"
+	RuleAdminService_GetWantedRegistry_FullMethodName       = "/bandit.services.ruleadmin.RuleAdminService/GetWantedRegistry"
+	RuleAdminService_GetWantedBandit_FullMethodName         = "/bandit.services.ruleadmin.RuleAdminService/GetWantedBandit"
+	RuleAdminService_UpdateWantedBandit_FullMethodName      = "/bandit.services.ruleadmin.RuleAdminService/UpdateWantedBandit"
+	RuleAdminService_DeprecateWantedBandit_FullMethodName   = "/bandit.services.ruleadmin.RuleAdminService/DeprecateWantedBandit"
+	RuleAdminService_ListStaleBanditRules_FullMethodName    = "/bandit.services.ruleadmin.RuleAdminService/ListStaleBanditRules"
+	RuleAdminService_ListAuditEvents_FullMethodName         = "/bandit.services.ruleadmin.RuleAdminService/ListAuditEvents"
+	RuleAdminService_CreateTenant_FullMethodName            = "/bandit.services.ruleadmin.RuleAdminService/CreateTenant"
+	RuleAdminService_UpdateTenant_FullMethodName            = "/bandit.services.ruleadmin.RuleAdminService/UpdateTenant"
+	RuleAdminService_SetTenantServices_FullMethodName       = "/bandit.services.ruleadmin.RuleAdminService/SetTenantServices"
+	RuleAdminService_GetTenant_FullMethodName               = "/bandit.services.ruleadmin.RuleAdminService/GetTenant"
+	RuleAdminService_ListTenants_FullMethodName             = "/bandit.services.ruleadmin.RuleAdminService/ListTenants"
+	RuleAdminService_ListChangeRequests_FullMethodName      = "/bandit.services.ruleadmin.RuleAdminService/ListChangeRequests"
+	RuleAdminService_GetChangeRequest_FullMethodName        = "/bandit.services.ruleadmin.RuleAdminService/GetChangeRequest"
+	RuleAdminService_SubmitChangeRequest_FullMethodName     = "/bandit.services.ruleadmin.RuleAdminService/SubmitChangeRequest"
+	RuleAdminService_ApproveChangeRequest_FullMethodName    = "/bandit.services.ruleadmin.RuleAdminService/ApproveChangeRequest"
+	RuleAdminService_RejectChangeRequest_FullMethodName     = "/bandit.services.ruleadmin.RuleAdminService/RejectChangeRequest"
+	RuleAdminService_ApplyChangeRequest_FullMethodName      = "/bandit.services.ruleadmin.RuleAdminService/ApplyChangeRequest"
 )
 
 // RuleAdminServiceClient is the client API for RuleAdminService service.
@@ -40,18 +87,65 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type RuleAdminServiceClient interface {
 	GetRule(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error)
 	CheckRule(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
 	CreateRule(ctx context.Context, in *CreateRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error)
 	UpdateRule(ctx context.Context, in *ModifyRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error)
 	SetRuleState(ctx context.Context, in *SetRuleStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteRule(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetRuleServiceContext(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*GetRuleServiceContextResponse, error)
+	SetRuleSchedule(ctx context.Context, in *SetRuleScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetRuleVariantSchema(ctx context.Context, in *SetRuleVariantSchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetRuleBanditConfig(ctx context.Context, in *SetRuleBanditConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetRuleTargeting(ctx context.Context, in *SetRuleTargetingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetRuleLayer(ctx context.Context, in *SetRuleLayerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListRuleRevisions(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*ListRuleRevisionsResponse, error)
+	GetRuleRevision(ctx context.Context, in *GetRuleRevisionRequest, opts ...grpc.CallOption) (*RuleRevisionResponse, error)
+	DiffRuleRevisions(ctx context.Context, in *DiffRuleRevisionsRequest, opts ...grpc.CallOption) (*DiffRuleRevisionsResponse, error)
+	RollbackRule(ctx context.Context, in *RollbackRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	SetRuleProtected(ctx context.Context, in *SetRuleProtectedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MigrateRuleBandit(ctx context.Context, in *MigrateRuleBanditRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	ResetStatistics(ctx context.Context, in *ResetStatisticsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CloneRule(ctx context.Context, in *CloneRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	CreateRuleTemplate(ctx context.Context, in *RuleTemplate, opts ...grpc.CallOption) (*RuleTemplateResponse, error)
+	GetRuleTemplate(ctx context.Context, in *GetRuleTemplateRequest, opts ...grpc.CallOption) (*RuleTemplateResponse, error)
+	ListRuleTemplates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListRuleTemplatesResponse, error)
+	DeleteRuleTemplate(ctx context.Context, in *GetRuleTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	InstantiateRuleTemplate(ctx context.Context, in *InstantiateRuleTemplateRequest, opts ...grpc.CallOption) (*RuleResponse, error)
+	ExportRules(ctx context.Context, in *ExportRulesRequest, opts ...grpc.CallOption) (*ExportRulesResponse, error)
+	ImportRules(ctx context.Context, in *ImportRulesRequest, opts ...grpc.CallOption) (*ImportRulesResponse, error)
+	CreateLayer(ctx context.Context, in *CreateLayerRequest, opts ...grpc.CallOption) (*LayerResponse, error)
+	GetLayer(ctx context.Context, in *GetLayerRequest, opts ...grpc.CallOption) (*LayerResponse, error)
 	GetVariant(ctx context.Context, in *GetVariantRequest, opts ...grpc.CallOption) (*VariantResponse, error)
 	CheckVariant(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
 	GetVariantData(ctx context.Context, in *GetVariantRequest, opts ...grpc.CallOption) (*VariantResponse, error)
 	AddVariant(ctx context.Context, in *AddVariantRequest, opts ...grpc.CallOption) (*VariantResponse, error)
+	UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*VariantResponse, error)
+	DeleteVariant(ctx context.Context, in *RemoveVariantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetVariantState(ctx context.Context, in *SetVariantStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetVariantSchedule(ctx context.Context, in *SetVariantScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddGuardrail(ctx context.Context, in *AddGuardrailRequest, opts ...grpc.CallOption) (*GuardrailResponse, error)
+	RemoveGuardrail(ctx context.Context, in *RemoveGuardrailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetRuleGuardrails(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*GetGuardrailsResponse, error)
+	ListGuardrails(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetGuardrailsResponse, error)
 	CreateWantedBandit(ctx context.Context, in *CreateWantedBanditRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetWantedRegistry(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetWantedRegistryResponse, error)
+	GetWantedBandit(ctx context.Context, in *GetWantedBanditRequest, opts ...grpc.CallOption) (*WantedBanditResponse, error)
+	UpdateWantedBandit(ctx context.Context, in *UpdateWantedBanditRequest, opts ...grpc.CallOption) (*WantedBanditResponse, error)
+	DeprecateWantedBandit(ctx context.Context, in *DeprecateWantedBanditRequest, opts ...grpc.CallOption) (*DeprecateWantedBanditResponse, error)
+	ListStaleBanditRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListStaleBanditRulesResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	CreateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*TenantResponse, error)
+	UpdateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*TenantResponse, error)
+	SetTenantServices(ctx context.Context, in *SetTenantServicesRequest, opts ...grpc.CallOption) (*TenantResponse, error)
+	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*TenantResponse, error)
+	ListTenants(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTenantsResponse, error)
+	ListChangeRequests(ctx context.Context, in *ListChangeRequestsRequest, opts ...grpc.CallOption) (*ListChangeRequestsResponse, error)
+	GetChangeRequest(ctx context.Context, in *GetChangeRequestRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error)
+	SubmitChangeRequest(ctx context.Context, in *GetChangeRequestRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error)
+	ApproveChangeRequest(ctx context.Context, in *ReviewChangeRequestRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error)
+	RejectChangeRequest(ctx context.Context, in *ReviewChangeRequestRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error)
+	ApplyChangeRequest(ctx context.Context, in *GetChangeRequestRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error)
 }
 
 type ruleAdminServiceClient struct {
@@ -71,6 +165,15 @@ func (c *ruleAdminServiceClient) GetRule(ctx context.Context, in *GetRuleRequest
 	return out, nil
 }
 
+func (c *ruleAdminServiceClient) ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error) {
+	out := new(ListRulesResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_ListRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ruleAdminServiceClient) CheckRule(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
 	out := new(CheckResponse)
 	err := c.cc.Invoke(ctx, RuleAdminService_CheckRule_FullMethodName, in, out, opts...)
@@ -107,6 +210,15 @@ func (c *ruleAdminServiceClient) SetRuleState(ctx context.Context, in *SetRuleSt
 	return out, nil
 }
 
+func (c *ruleAdminServiceClient) DeleteRule(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleAdminService_DeleteRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ruleAdminServiceClient) GetRuleServiceContext(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*GetRuleServiceContextResponse, error) {
 	out := new(GetRuleServiceContextResponse)
 	err := c.cc.Invoke(ctx, RuleAdminService_GetRuleServiceContext_FullMethodName, in, out, opts...)
@@ -116,6 +228,204 @@ func (c *ruleAdminServiceClient) GetRuleServiceContext(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *ruleAdminServiceClient) SetRuleSchedule(ctx context.Context, in *SetRuleScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleAdminService_SetRuleSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) SetRuleVariantSchema(ctx context.Context, in *SetRuleVariantSchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleAdminService_SetRuleVariantSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) SetRuleBanditConfig(ctx context.Context, in *SetRuleBanditConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleAdminService_SetRuleBanditConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) SetRuleTargeting(ctx context.Context, in *SetRuleTargetingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleAdminService_SetRuleTargeting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) SetRuleLayer(ctx context.Context, in *SetRuleLayerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleAdminService_SetRuleLayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) ListRuleRevisions(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*ListRuleRevisionsResponse, error) {
+	out := new(ListRuleRevisionsResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_ListRuleRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) GetRuleRevision(ctx context.Context, in *GetRuleRevisionRequest, opts ...grpc.CallOption) (*RuleRevisionResponse, error) {
+	out := new(RuleRevisionResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_GetRuleRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) DiffRuleRevisions(ctx context.Context, in *DiffRuleRevisionsRequest, opts ...grpc.CallOption) (*DiffRuleRevisionsResponse, error) {
+	out := new(DiffRuleRevisionsResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_DiffRuleRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) RollbackRule(ctx context.Context, in *RollbackRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error) {
+	out := new(RuleResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_RollbackRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) SetRuleProtected(ctx context.Context, in *SetRuleProtectedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleAdminService_SetRuleProtected_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) MigrateRuleBandit(ctx context.Context, in *MigrateRuleBanditRequest, opts ...grpc.CallOption) (*RuleResponse, error) {
+	out := new(RuleResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_MigrateRuleBandit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) ResetStatistics(ctx context.Context, in *ResetStatisticsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleAdminService_ResetStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) CloneRule(ctx context.Context, in *CloneRuleRequest, opts ...grpc.CallOption) (*RuleResponse, error) {
+	out := new(RuleResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_CloneRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) CreateRuleTemplate(ctx context.Context, in *RuleTemplate, opts ...grpc.CallOption) (*RuleTemplateResponse, error) {
+	out := new(RuleTemplateResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_CreateRuleTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) GetRuleTemplate(ctx context.Context, in *GetRuleTemplateRequest, opts ...grpc.CallOption) (*RuleTemplateResponse, error) {
+	out := new(RuleTemplateResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_GetRuleTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) ListRuleTemplates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListRuleTemplatesResponse, error) {
+	out := new(ListRuleTemplatesResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_ListRuleTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) DeleteRuleTemplate(ctx context.Context, in *GetRuleTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleAdminService_DeleteRuleTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) InstantiateRuleTemplate(ctx context.Context, in *InstantiateRuleTemplateRequest, opts ...grpc.CallOption) (*RuleResponse, error) {
+	out := new(RuleResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_InstantiateRuleTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) ExportRules(ctx context.Context, in *ExportRulesRequest, opts ...grpc.CallOption) (*ExportRulesResponse, error) {
+	out := new(ExportRulesResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_ExportRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) ImportRules(ctx context.Context, in *ImportRulesRequest, opts ...grpc.CallOption) (*ImportRulesResponse, error) {
+	out := new(ImportRulesResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_ImportRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) CreateLayer(ctx context.Context, in *CreateLayerRequest, opts ...grpc.CallOption) (*LayerResponse, error) {
+	out := new(LayerResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_CreateLayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) GetLayer(ctx context.Context, in *GetLayerRequest, opts ...grpc.CallOption) (*LayerResponse, error) {
+	out := new(LayerResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_GetLayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ruleAdminServiceClient) GetVariant(ctx context.Context, in *GetVariantRequest, opts ...grpc.CallOption) (*VariantResponse, error) {
 	out := new(VariantResponse)
 	err := c.cc.Invoke(ctx, RuleAdminService_GetVariant_FullMethodName, in, out, opts...)
@@ -152,6 +462,24 @@ func (c *ruleAdminServiceClient) AddVariant(ctx context.Context, in *AddVariantR
 	return out, nil
 }
 
+func (c *ruleAdminServiceClient) UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*VariantResponse, error) {
+	out := new(VariantResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_UpdateVariant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) DeleteVariant(ctx context.Context, in *RemoveVariantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleAdminService_DeleteVariant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ruleAdminServiceClient) SetVariantState(ctx context.Context, in *SetVariantStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, RuleAdminService_SetVariantState_FullMethodName, in, out, opts...)
@@ -161,6 +489,51 @@ func (c *ruleAdminServiceClient) SetVariantState(ctx context.Context, in *SetVar
 	return out, nil
 }
 
+func (c *ruleAdminServiceClient) SetVariantSchedule(ctx context.Context, in *SetVariantScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleAdminService_SetVariantSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) AddGuardrail(ctx context.Context, in *AddGuardrailRequest, opts ...grpc.CallOption) (*GuardrailResponse, error) {
+	out := new(GuardrailResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_AddGuardrail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) RemoveGuardrail(ctx context.Context, in *RemoveGuardrailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RuleAdminService_RemoveGuardrail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) GetRuleGuardrails(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*GetGuardrailsResponse, error) {
+	out := new(GetGuardrailsResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_GetRuleGuardrails_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) ListGuardrails(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetGuardrailsResponse, error) {
+	out := new(GetGuardrailsResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_ListGuardrails_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ruleAdminServiceClient) CreateWantedBandit(ctx context.Context, in *CreateWantedBanditRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, RuleAdminService_CreateWantedBandit_FullMethodName, in, out, opts...)
@@ -179,48 +552,311 @@ func (c *ruleAdminServiceClient) GetWantedRegistry(ctx context.Context, in *empt
 	return out, nil
 }
 
-// RuleAdminServiceServer is the server API for RuleAdminService service.
-// All implementations must embed UnimplementedRuleAdminServiceServer
-// for forward compatibility
-type RuleAdminServiceServer interface {
-	GetRule(context.Context, *GetRuleRequest) (*RuleResponse, error)
-	CheckRule(context.Context, *CheckRequest) (*CheckResponse, error)
-	CreateRule(context.Context, *CreateRuleRequest) (*RuleResponse, error)
-	UpdateRule(context.Context, *ModifyRuleRequest) (*RuleResponse, error)
-	SetRuleState(context.Context, *SetRuleStateRequest) (*emptypb.Empty, error)
-	GetRuleServiceContext(context.Context, *GetRuleRequest) (*GetRuleServiceContextResponse, error)
-	GetVariant(context.Context, *GetVariantRequest) (*VariantResponse, error)
-	CheckVariant(context.Context, *CheckRequest) (*CheckResponse, error)
-	GetVariantData(context.Context, *GetVariantRequest) (*VariantResponse, error)
-	AddVariant(context.Context, *AddVariantRequest) (*VariantResponse, error)
-	SetVariantState(context.Context, *SetVariantStateRequest) (*emptypb.Empty, error)
-	CreateWantedBandit(context.Context, *CreateWantedBanditRequest) (*emptypb.Empty, error)
-	GetWantedRegistry(context.Context, *emptypb.Empty) (*GetWantedRegistryResponse, error)
-	mustEmbedUnimplementedRuleAdminServiceServer()
+func (c *ruleAdminServiceClient) GetWantedBandit(ctx context.Context, in *GetWantedBanditRequest, opts ...grpc.CallOption) (*WantedBanditResponse, error) {
+	out := new(WantedBanditResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_GetWantedBandit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedRuleAdminServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedRuleAdminServiceServer struct {
+func (c *ruleAdminServiceClient) UpdateWantedBandit(ctx context.Context, in *UpdateWantedBanditRequest, opts ...grpc.CallOption) (*WantedBanditResponse, error) {
+	out := new(WantedBanditResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_UpdateWantedBandit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedRuleAdminServiceServer) GetRule(context.Context, *GetRuleRequest) (*RuleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRule not implemented")
-}
-func (UnimplementedRuleAdminServiceServer) CheckRule(context.Context, *CheckRequest) (*CheckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckRule not implemented")
+func (c *ruleAdminServiceClient) DeprecateWantedBandit(ctx context.Context, in *DeprecateWantedBanditRequest, opts ...grpc.CallOption) (*DeprecateWantedBanditResponse, error) {
+	out := new(DeprecateWantedBanditResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_DeprecateWantedBandit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRuleAdminServiceServer) CreateRule(context.Context, *CreateRuleRequest) (*RuleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRule not implemented")
+
+func (c *ruleAdminServiceClient) ListStaleBanditRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListStaleBanditRulesResponse, error) {
+	out := new(ListStaleBanditRulesResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_ListStaleBanditRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRuleAdminServiceServer) UpdateRule(context.Context, *ModifyRuleRequest) (*RuleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRule not implemented")
+
+func (c *ruleAdminServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRuleAdminServiceServer) SetRuleState(context.Context, *SetRuleStateRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRuleState not implemented")
+
+func (c *ruleAdminServiceClient) CreateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*TenantResponse, error) {
+	out := new(TenantResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_CreateTenant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) UpdateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*TenantResponse, error) {
+	out := new(TenantResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_UpdateTenant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) SetTenantServices(ctx context.Context, in *SetTenantServicesRequest, opts ...grpc.CallOption) (*TenantResponse, error) {
+	out := new(TenantResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_SetTenantServices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*TenantResponse, error) {
+	out := new(TenantResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_GetTenant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) ListTenants(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTenantsResponse, error) {
+	out := new(ListTenantsResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_ListTenants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) ListChangeRequests(ctx context.Context, in *ListChangeRequestsRequest, opts ...grpc.CallOption) (*ListChangeRequestsResponse, error) {
+	out := new(ListChangeRequestsResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_ListChangeRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) GetChangeRequest(ctx context.Context, in *GetChangeRequestRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error) {
+	out := new(ChangeRequestResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_GetChangeRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) SubmitChangeRequest(ctx context.Context, in *GetChangeRequestRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error) {
+	out := new(ChangeRequestResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_SubmitChangeRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) ApproveChangeRequest(ctx context.Context, in *ReviewChangeRequestRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error) {
+	out := new(ChangeRequestResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_ApproveChangeRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) RejectChangeRequest(ctx context.Context, in *ReviewChangeRequestRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error) {
+	out := new(ChangeRequestResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_RejectChangeRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleAdminServiceClient) ApplyChangeRequest(ctx context.Context, in *GetChangeRequestRequest, opts ...grpc.CallOption) (*ChangeRequestResponse, error) {
+	out := new(ChangeRequestResponse)
+	err := c.cc.Invoke(ctx, RuleAdminService_ApplyChangeRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RuleAdminServiceServer is the server API for RuleAdminService service.
+// All implementations must embed UnimplementedRuleAdminServiceServer
+// for forward compatibility
+type RuleAdminServiceServer interface {
+	GetRule(context.Context, *GetRuleRequest) (*RuleResponse, error)
+	ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error)
+	CheckRule(context.Context, *CheckRequest) (*CheckResponse, error)
+	CreateRule(context.Context, *CreateRuleRequest) (*RuleResponse, error)
+	UpdateRule(context.Context, *ModifyRuleRequest) (*RuleResponse, error)
+	SetRuleState(context.Context, *SetRuleStateRequest) (*emptypb.Empty, error)
+	DeleteRule(context.Context, *GetRuleRequest) (*emptypb.Empty, error)
+	GetRuleServiceContext(context.Context, *GetRuleRequest) (*GetRuleServiceContextResponse, error)
+	SetRuleSchedule(context.Context, *SetRuleScheduleRequest) (*emptypb.Empty, error)
+	SetRuleVariantSchema(context.Context, *SetRuleVariantSchemaRequest) (*emptypb.Empty, error)
+	SetRuleBanditConfig(context.Context, *SetRuleBanditConfigRequest) (*emptypb.Empty, error)
+	SetRuleTargeting(context.Context, *SetRuleTargetingRequest) (*emptypb.Empty, error)
+	SetRuleLayer(context.Context, *SetRuleLayerRequest) (*emptypb.Empty, error)
+	ListRuleRevisions(context.Context, *GetRuleRequest) (*ListRuleRevisionsResponse, error)
+	GetRuleRevision(context.Context, *GetRuleRevisionRequest) (*RuleRevisionResponse, error)
+	DiffRuleRevisions(context.Context, *DiffRuleRevisionsRequest) (*DiffRuleRevisionsResponse, error)
+	RollbackRule(context.Context, *RollbackRuleRequest) (*RuleResponse, error)
+	SetRuleProtected(context.Context, *SetRuleProtectedRequest) (*emptypb.Empty, error)
+	MigrateRuleBandit(context.Context, *MigrateRuleBanditRequest) (*RuleResponse, error)
+	ResetStatistics(context.Context, *ResetStatisticsRequest) (*emptypb.Empty, error)
+	CloneRule(context.Context, *CloneRuleRequest) (*RuleResponse, error)
+	CreateRuleTemplate(context.Context, *RuleTemplate) (*RuleTemplateResponse, error)
+	GetRuleTemplate(context.Context, *GetRuleTemplateRequest) (*RuleTemplateResponse, error)
+	ListRuleTemplates(context.Context, *emptypb.Empty) (*ListRuleTemplatesResponse, error)
+	DeleteRuleTemplate(context.Context, *GetRuleTemplateRequest) (*emptypb.Empty, error)
+	InstantiateRuleTemplate(context.Context, *InstantiateRuleTemplateRequest) (*RuleResponse, error)
+	ExportRules(context.Context, *ExportRulesRequest) (*ExportRulesResponse, error)
+	ImportRules(context.Context, *ImportRulesRequest) (*ImportRulesResponse, error)
+	CreateLayer(context.Context, *CreateLayerRequest) (*LayerResponse, error)
+	GetLayer(context.Context, *GetLayerRequest) (*LayerResponse, error)
+	GetVariant(context.Context, *GetVariantRequest) (*VariantResponse, error)
+	CheckVariant(context.Context, *CheckRequest) (*CheckResponse, error)
+	GetVariantData(context.Context, *GetVariantRequest) (*VariantResponse, error)
+	AddVariant(context.Context, *AddVariantRequest) (*VariantResponse, error)
+	UpdateVariant(context.Context, *UpdateVariantRequest) (*VariantResponse, error)
+	DeleteVariant(context.Context, *RemoveVariantRequest) (*emptypb.Empty, error)
+	SetVariantState(context.Context, *SetVariantStateRequest) (*emptypb.Empty, error)
+	SetVariantSchedule(context.Context, *SetVariantScheduleRequest) (*emptypb.Empty, error)
+	AddGuardrail(context.Context, *AddGuardrailRequest) (*GuardrailResponse, error)
+	RemoveGuardrail(context.Context, *RemoveGuardrailRequest) (*emptypb.Empty, error)
+	GetRuleGuardrails(context.Context, *GetRuleRequest) (*GetGuardrailsResponse, error)
+	ListGuardrails(context.Context, *emptypb.Empty) (*GetGuardrailsResponse, error)
+	CreateWantedBandit(context.Context, *CreateWantedBanditRequest) (*emptypb.Empty, error)
+	GetWantedRegistry(context.Context, *emptypb.Empty) (*GetWantedRegistryResponse, error)
+	GetWantedBandit(context.Context, *GetWantedBanditRequest) (*WantedBanditResponse, error)
+	UpdateWantedBandit(context.Context, *UpdateWantedBanditRequest) (*WantedBanditResponse, error)
+	DeprecateWantedBandit(context.Context, *DeprecateWantedBanditRequest) (*DeprecateWantedBanditResponse, error)
+	ListStaleBanditRules(context.Context, *emptypb.Empty) (*ListStaleBanditRulesResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	CreateTenant(context.Context, *Tenant) (*TenantResponse, error)
+	UpdateTenant(context.Context, *Tenant) (*TenantResponse, error)
+	SetTenantServices(context.Context, *SetTenantServicesRequest) (*TenantResponse, error)
+	GetTenant(context.Context, *GetTenantRequest) (*TenantResponse, error)
+	ListTenants(context.Context, *emptypb.Empty) (*ListTenantsResponse, error)
+	ListChangeRequests(context.Context, *ListChangeRequestsRequest) (*ListChangeRequestsResponse, error)
+	GetChangeRequest(context.Context, *GetChangeRequestRequest) (*ChangeRequestResponse, error)
+	SubmitChangeRequest(context.Context, *GetChangeRequestRequest) (*ChangeRequestResponse, error)
+	ApproveChangeRequest(context.Context, *ReviewChangeRequestRequest) (*ChangeRequestResponse, error)
+	RejectChangeRequest(context.Context, *ReviewChangeRequestRequest) (*ChangeRequestResponse, error)
+	ApplyChangeRequest(context.Context, *GetChangeRequestRequest) (*ChangeRequestResponse, error)
+	mustEmbedUnimplementedRuleAdminServiceServer()
+}
+
+// UnimplementedRuleAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRuleAdminServiceServer struct {
+}
+
+func (UnimplementedRuleAdminServiceServer) GetRule(context.Context, *GetRuleRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRule not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRules not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) CheckRule(context.Context, *CheckRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckRule not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) CreateRule(context.Context, *CreateRuleRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRule not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) UpdateRule(context.Context, *ModifyRuleRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRule not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) SetRuleState(context.Context, *SetRuleStateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRuleState not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) DeleteRule(context.Context, *GetRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
 }
 func (UnimplementedRuleAdminServiceServer) GetRuleServiceContext(context.Context, *GetRuleRequest) (*GetRuleServiceContextResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRuleServiceContext not implemented")
 }
+func (UnimplementedRuleAdminServiceServer) SetRuleSchedule(context.Context, *SetRuleScheduleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRuleSchedule not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) SetRuleVariantSchema(context.Context, *SetRuleVariantSchemaRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRuleVariantSchema not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) SetRuleBanditConfig(context.Context, *SetRuleBanditConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRuleBanditConfig not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) SetRuleTargeting(context.Context, *SetRuleTargetingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRuleTargeting not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) SetRuleLayer(context.Context, *SetRuleLayerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRuleLayer not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ListRuleRevisions(context.Context, *GetRuleRequest) (*ListRuleRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuleRevisions not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) GetRuleRevision(context.Context, *GetRuleRevisionRequest) (*RuleRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuleRevision not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) DiffRuleRevisions(context.Context, *DiffRuleRevisionsRequest) (*DiffRuleRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRuleRevisions not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) RollbackRule(context.Context, *RollbackRuleRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackRule not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) SetRuleProtected(context.Context, *SetRuleProtectedRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRuleProtected not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) MigrateRuleBandit(context.Context, *MigrateRuleBanditRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateRuleBandit not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ResetStatistics(context.Context, *ResetStatisticsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetStatistics not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) CloneRule(context.Context, *CloneRuleRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneRule not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) CreateRuleTemplate(context.Context, *RuleTemplate) (*RuleTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRuleTemplate not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) GetRuleTemplate(context.Context, *GetRuleTemplateRequest) (*RuleTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuleTemplate not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ListRuleTemplates(context.Context, *emptypb.Empty) (*ListRuleTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuleTemplates not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) DeleteRuleTemplate(context.Context, *GetRuleTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRuleTemplate not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) InstantiateRuleTemplate(context.Context, *InstantiateRuleTemplateRequest) (*RuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateRuleTemplate not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ExportRules(context.Context, *ExportRulesRequest) (*ExportRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportRules not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ImportRules(context.Context, *ImportRulesRequest) (*ImportRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRules not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) CreateLayer(context.Context, *CreateLayerRequest) (*LayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLayer not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) GetLayer(context.Context, *GetLayerRequest) (*LayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLayer not implemented")
+}
 func (UnimplementedRuleAdminServiceServer) GetVariant(context.Context, *GetVariantRequest) (*VariantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVariant not implemented")
 }
@@ -233,258 +869,1173 @@ func (UnimplementedRuleAdminServiceServer) GetVariantData(context.Context, *GetV
 func (UnimplementedRuleAdminServiceServer) AddVariant(context.Context, *AddVariantRequest) (*VariantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddVariant not implemented")
 }
-func (UnimplementedRuleAdminServiceServer) SetVariantState(context.Context, *SetVariantStateRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetVariantState not implemented")
+func (UnimplementedRuleAdminServiceServer) UpdateVariant(context.Context, *UpdateVariantRequest) (*VariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVariant not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) DeleteVariant(context.Context, *RemoveVariantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVariant not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) SetVariantState(context.Context, *SetVariantStateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVariantState not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) SetVariantSchedule(context.Context, *SetVariantScheduleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVariantSchedule not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) AddGuardrail(context.Context, *AddGuardrailRequest) (*GuardrailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGuardrail not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) RemoveGuardrail(context.Context, *RemoveGuardrailRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGuardrail not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) GetRuleGuardrails(context.Context, *GetRuleRequest) (*GetGuardrailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuleGuardrails not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ListGuardrails(context.Context, *emptypb.Empty) (*GetGuardrailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGuardrails not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) CreateWantedBandit(context.Context, *CreateWantedBanditRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWantedBandit not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) GetWantedRegistry(context.Context, *emptypb.Empty) (*GetWantedRegistryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWantedRegistry not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) GetWantedBandit(context.Context, *GetWantedBanditRequest) (*WantedBanditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWantedBandit not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) UpdateWantedBandit(context.Context, *UpdateWantedBanditRequest) (*WantedBanditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWantedBandit not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) DeprecateWantedBandit(context.Context, *DeprecateWantedBanditRequest) (*DeprecateWantedBanditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateWantedBandit not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ListStaleBanditRules(context.Context, *emptypb.Empty) (*ListStaleBanditRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaleBanditRules not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) CreateTenant(context.Context, *Tenant) (*TenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) UpdateTenant(context.Context, *Tenant) (*TenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenant not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) SetTenantServices(context.Context, *SetTenantServicesRequest) (*TenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTenantServices not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) GetTenant(context.Context, *GetTenantRequest) (*TenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ListTenants(context.Context, *emptypb.Empty) (*ListTenantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenants not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ListChangeRequests(context.Context, *ListChangeRequestsRequest) (*ListChangeRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChangeRequests not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) GetChangeRequest(context.Context, *GetChangeRequestRequest) (*ChangeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangeRequest not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) SubmitChangeRequest(context.Context, *GetChangeRequestRequest) (*ChangeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitChangeRequest not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ApproveChangeRequest(context.Context, *ReviewChangeRequestRequest) (*ChangeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveChangeRequest not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) RejectChangeRequest(context.Context, *ReviewChangeRequestRequest) (*ChangeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectChangeRequest not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) ApplyChangeRequest(context.Context, *GetChangeRequestRequest) (*ChangeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyChangeRequest not implemented")
+}
+func (UnimplementedRuleAdminServiceServer) mustEmbedUnimplementedRuleAdminServiceServer() {}
+
+// UnsafeRuleAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RuleAdminServiceServer will
+// result in compilation errors.
+type UnsafeRuleAdminServiceServer interface {
+	mustEmbedUnimplementedRuleAdminServiceServer()
+}
+
+func RegisterRuleAdminServiceServer(s grpc.ServiceRegistrar, srv RuleAdminServiceServer) {
+	s.RegisterService(&RuleAdminService_ServiceDesc, srv)
+}
+
+func _RuleAdminService_GetRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).GetRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_GetRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).GetRule(ctx, req.(*GetRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_ListRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).ListRules(ctx, req.(*ListRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_CheckRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).CheckRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_CheckRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).CheckRule(ctx, req.(*CheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_CreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).CreateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_CreateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).CreateRule(ctx, req.(*CreateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_UpdateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).UpdateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_UpdateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).UpdateRule(ctx, req.(*ModifyRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_SetRuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRuleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).SetRuleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_SetRuleState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).SetRuleState(ctx, req.(*SetRuleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_DeleteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).DeleteRule(ctx, req.(*GetRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_GetRuleServiceContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).GetRuleServiceContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_GetRuleServiceContext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).GetRuleServiceContext(ctx, req.(*GetRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_SetRuleSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRuleScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).SetRuleSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_SetRuleSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).SetRuleSchedule(ctx, req.(*SetRuleScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_SetRuleVariantSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRuleVariantSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).SetRuleVariantSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_SetRuleVariantSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).SetRuleVariantSchema(ctx, req.(*SetRuleVariantSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_SetRuleBanditConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRuleBanditConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).SetRuleBanditConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_SetRuleBanditConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).SetRuleBanditConfig(ctx, req.(*SetRuleBanditConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_SetRuleTargeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRuleTargetingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).SetRuleTargeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_SetRuleTargeting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).SetRuleTargeting(ctx, req.(*SetRuleTargetingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_SetRuleLayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRuleLayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).SetRuleLayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_SetRuleLayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).SetRuleLayer(ctx, req.(*SetRuleLayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_ListRuleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).ListRuleRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_ListRuleRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).ListRuleRevisions(ctx, req.(*GetRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_GetRuleRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).GetRuleRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_GetRuleRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).GetRuleRevision(ctx, req.(*GetRuleRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_DiffRuleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRuleRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).DiffRuleRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_DiffRuleRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).DiffRuleRevisions(ctx, req.(*DiffRuleRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_RollbackRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).RollbackRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_RollbackRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).RollbackRule(ctx, req.(*RollbackRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_SetRuleProtected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRuleProtectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).SetRuleProtected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_SetRuleProtected_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).SetRuleProtected(ctx, req.(*SetRuleProtectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_MigrateRuleBandit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateRuleBanditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).MigrateRuleBandit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_MigrateRuleBandit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).MigrateRuleBandit(ctx, req.(*MigrateRuleBanditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_ResetStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).ResetStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_ResetStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).ResetStatistics(ctx, req.(*ResetStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_CloneRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).CloneRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_CloneRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).CloneRule(ctx, req.(*CloneRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_CreateRuleTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).CreateRuleTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_CreateRuleTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).CreateRuleTemplate(ctx, req.(*RuleTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_GetRuleTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).GetRuleTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_GetRuleTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).GetRuleTemplate(ctx, req.(*GetRuleTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_ListRuleTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).ListRuleTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_ListRuleTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).ListRuleTemplates(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_DeleteRuleTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).DeleteRuleTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_DeleteRuleTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).DeleteRuleTemplate(ctx, req.(*GetRuleTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_InstantiateRuleTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiateRuleTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).InstantiateRuleTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_InstantiateRuleTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).InstantiateRuleTemplate(ctx, req.(*InstantiateRuleTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_ExportRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).ExportRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_ExportRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).ExportRules(ctx, req.(*ExportRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_ImportRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).ImportRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_ImportRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).ImportRules(ctx, req.(*ImportRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_CreateLayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).CreateLayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_CreateLayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).CreateLayer(ctx, req.(*CreateLayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_GetLayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).GetLayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_GetLayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).GetLayer(ctx, req.(*GetLayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_GetVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).GetVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_GetVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).GetVariant(ctx, req.(*GetVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_CheckVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).CheckVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_CheckVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).CheckVariant(ctx, req.(*CheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_GetVariantData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).GetVariantData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_GetVariantData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).GetVariantData(ctx, req.(*GetVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_AddVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).AddVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_AddVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).AddVariant(ctx, req.(*AddVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_UpdateVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).UpdateVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_UpdateVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).UpdateVariant(ctx, req.(*UpdateVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_DeleteVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).DeleteVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_DeleteVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).DeleteVariant(ctx, req.(*RemoveVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_SetVariantState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVariantStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).SetVariantState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_SetVariantState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).SetVariantState(ctx, req.(*SetVariantStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_SetVariantSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVariantScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).SetVariantSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_SetVariantSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).SetVariantSchedule(ctx, req.(*SetVariantScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_AddGuardrail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGuardrailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).AddGuardrail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_AddGuardrail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).AddGuardrail(ctx, req.(*AddGuardrailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_RemoveGuardrail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGuardrailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).RemoveGuardrail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_RemoveGuardrail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).RemoveGuardrail(ctx, req.(*RemoveGuardrailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_GetRuleGuardrails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).GetRuleGuardrails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_GetRuleGuardrails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).GetRuleGuardrails(ctx, req.(*GetRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_ListGuardrails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).ListGuardrails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_ListGuardrails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).ListGuardrails(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleAdminService_CreateWantedBandit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWantedBanditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).CreateWantedBandit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_CreateWantedBandit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).CreateWantedBandit(ctx, req.(*CreateWantedBanditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRuleAdminServiceServer) CreateWantedBandit(context.Context, *CreateWantedBanditRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateWantedBandit not implemented")
+
+func _RuleAdminService_GetWantedRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).GetWantedRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_GetWantedRegistry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).GetWantedRegistry(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRuleAdminServiceServer) GetWantedRegistry(context.Context, *emptypb.Empty) (*GetWantedRegistryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWantedRegistry not implemented")
+
+func _RuleAdminService_GetWantedBandit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWantedBanditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).GetWantedBandit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_GetWantedBandit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).GetWantedBandit(ctx, req.(*GetWantedBanditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRuleAdminServiceServer) mustEmbedUnimplementedRuleAdminServiceServer() {}
 
-// UnsafeRuleAdminServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RuleAdminServiceServer will
-// result in compilation errors.
-type UnsafeRuleAdminServiceServer interface {
-	mustEmbedUnimplementedRuleAdminServiceServer()
+func _RuleAdminService_UpdateWantedBandit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWantedBanditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).UpdateWantedBandit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_UpdateWantedBandit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).UpdateWantedBandit(ctx, req.(*UpdateWantedBanditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRuleAdminServiceServer(s grpc.ServiceRegistrar, srv RuleAdminServiceServer) {
-	s.RegisterService(&RuleAdminService_ServiceDesc, srv)
+func _RuleAdminService_DeprecateWantedBandit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeprecateWantedBanditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleAdminServiceServer).DeprecateWantedBandit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleAdminService_DeprecateWantedBandit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleAdminServiceServer).DeprecateWantedBandit(ctx, req.(*DeprecateWantedBanditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RuleAdminService_GetRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRuleRequest)
+func _RuleAdminService_ListStaleBanditRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuleAdminServiceServer).GetRule(ctx, in)
+		return srv.(RuleAdminServiceServer).ListStaleBanditRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RuleAdminService_GetRule_FullMethodName,
+		FullMethod: RuleAdminService_ListStaleBanditRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuleAdminServiceServer).GetRule(ctx, req.(*GetRuleRequest))
+		return srv.(RuleAdminServiceServer).ListStaleBanditRules(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuleAdminService_CheckRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckRequest)
+func _RuleAdminService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuleAdminServiceServer).CheckRule(ctx, in)
+		return srv.(RuleAdminServiceServer).ListAuditEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RuleAdminService_CheckRule_FullMethodName,
+		FullMethod: RuleAdminService_ListAuditEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuleAdminServiceServer).CheckRule(ctx, req.(*CheckRequest))
+		return srv.(RuleAdminServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuleAdminService_CreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRuleRequest)
+func _RuleAdminService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tenant)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuleAdminServiceServer).CreateRule(ctx, in)
+		return srv.(RuleAdminServiceServer).CreateTenant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RuleAdminService_CreateRule_FullMethodName,
+		FullMethod: RuleAdminService_CreateTenant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuleAdminServiceServer).CreateRule(ctx, req.(*CreateRuleRequest))
+		return srv.(RuleAdminServiceServer).CreateTenant(ctx, req.(*Tenant))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuleAdminService_UpdateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyRuleRequest)
+func _RuleAdminService_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tenant)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuleAdminServiceServer).UpdateRule(ctx, in)
+		return srv.(RuleAdminServiceServer).UpdateTenant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RuleAdminService_UpdateRule_FullMethodName,
+		FullMethod: RuleAdminService_UpdateTenant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuleAdminServiceServer).UpdateRule(ctx, req.(*ModifyRuleRequest))
+		return srv.(RuleAdminServiceServer).UpdateTenant(ctx, req.(*Tenant))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuleAdminService_SetRuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetRuleStateRequest)
+func _RuleAdminService_SetTenantServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTenantServicesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuleAdminServiceServer).SetRuleState(ctx, in)
+		return srv.(RuleAdminServiceServer).SetTenantServices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RuleAdminService_SetRuleState_FullMethodName,
+		FullMethod: RuleAdminService_SetTenantServices_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuleAdminServiceServer).SetRuleState(ctx, req.(*SetRuleStateRequest))
+		return srv.(RuleAdminServiceServer).SetTenantServices(ctx, req.(*SetTenantServicesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuleAdminService_GetRuleServiceContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRuleRequest)
+func _RuleAdminService_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuleAdminServiceServer).GetRuleServiceContext(ctx, in)
+		return srv.(RuleAdminServiceServer).GetTenant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RuleAdminService_GetRuleServiceContext_FullMethodName,
+		FullMethod: RuleAdminService_GetTenant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuleAdminServiceServer).GetRuleServiceContext(ctx, req.(*GetRuleRequest))
+		return srv.(RuleAdminServiceServer).GetTenant(ctx, req.(*GetTenantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuleAdminService_GetVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVariantRequest)
+func _RuleAdminService_ListTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuleAdminServiceServer).GetVariant(ctx, in)
+		return srv.(RuleAdminServiceServer).ListTenants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RuleAdminService_GetVariant_FullMethodName,
+		FullMethod: RuleAdminService_ListTenants_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuleAdminServiceServer).GetVariant(ctx, req.(*GetVariantRequest))
+		return srv.(RuleAdminServiceServer).ListTenants(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuleAdminService_CheckVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckRequest)
+func _RuleAdminService_ListChangeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChangeRequestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuleAdminServiceServer).CheckVariant(ctx, in)
+		return srv.(RuleAdminServiceServer).ListChangeRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RuleAdminService_CheckVariant_FullMethodName,
+		FullMethod: RuleAdminService_ListChangeRequests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuleAdminServiceServer).CheckVariant(ctx, req.(*CheckRequest))
+		return srv.(RuleAdminServiceServer).ListChangeRequests(ctx, req.(*ListChangeRequestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuleAdminService_GetVariantData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVariantRequest)
+func _RuleAdminService_GetChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangeRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuleAdminServiceServer).GetVariantData(ctx, in)
+		return srv.(RuleAdminServiceServer).GetChangeRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RuleAdminService_GetVariantData_FullMethodName,
+		FullMethod: RuleAdminService_GetChangeRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuleAdminServiceServer).GetVariantData(ctx, req.(*GetVariantRequest))
+		return srv.(RuleAdminServiceServer).GetChangeRequest(ctx, req.(*GetChangeRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuleAdminService_AddVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddVariantRequest)
+func _RuleAdminService_SubmitChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangeRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuleAdminServiceServer).AddVariant(ctx, in)
+		return srv.(RuleAdminServiceServer).SubmitChangeRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RuleAdminService_AddVariant_FullMethodName,
+		FullMethod: RuleAdminService_SubmitChangeRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuleAdminServiceServer).AddVariant(ctx, req.(*AddVariantRequest))
+		return srv.(RuleAdminServiceServer).SubmitChangeRequest(ctx, req.(*GetChangeRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuleAdminService_SetVariantState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetVariantStateRequest)
+func _RuleAdminService_ApproveChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewChangeRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuleAdminServiceServer).SetVariantState(ctx, in)
+		return srv.(RuleAdminServiceServer).ApproveChangeRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RuleAdminService_SetVariantState_FullMethodName,
+		FullMethod: RuleAdminService_ApproveChangeRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuleAdminServiceServer).SetVariantState(ctx, req.(*SetVariantStateRequest))
+		return srv.(RuleAdminServiceServer).ApproveChangeRequest(ctx, req.(*ReviewChangeRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuleAdminService_CreateWantedBandit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateWantedBanditRequest)
+func _RuleAdminService_RejectChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewChangeRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuleAdminServiceServer).CreateWantedBandit(ctx, in)
+		return srv.(RuleAdminServiceServer).RejectChangeRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RuleAdminService_CreateWantedBandit_FullMethodName,
+		FullMethod: RuleAdminService_RejectChangeRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuleAdminServiceServer).CreateWantedBandit(ctx, req.(*CreateWantedBanditRequest))
+		return srv.(RuleAdminServiceServer).RejectChangeRequest(ctx, req.(*ReviewChangeRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RuleAdminService_GetWantedRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _RuleAdminService_ApplyChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangeRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RuleAdminServiceServer).GetWantedRegistry(ctx, in)
+		return srv.(RuleAdminServiceServer).ApplyChangeRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RuleAdminService_GetWantedRegistry_FullMethodName,
+		FullMethod: RuleAdminService_ApplyChangeRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RuleAdminServiceServer).GetWantedRegistry(ctx, req.(*emptypb.Empty))
+		return srv.(RuleAdminServiceServer).ApplyChangeRequest(ctx, req.(*GetChangeRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -500,6 +2051,10 @@ var RuleAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRule",
 			Handler:    _RuleAdminService_GetRule_Handler,
 		},
+		{
+			MethodName: "ListRules",
+			Handler:    _RuleAdminService_ListRules_Handler,
+		},
 		{
 			MethodName: "CheckRule",
 			Handler:    _RuleAdminService_CheckRule_Handler,
@@ -516,10 +2071,102 @@ var RuleAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetRuleState",
 			Handler:    _RuleAdminService_SetRuleState_Handler,
 		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _RuleAdminService_DeleteRule_Handler,
+		},
 		{
 			MethodName: "GetRuleServiceContext",
 			Handler:    _RuleAdminService_GetRuleServiceContext_Handler,
 		},
+		{
+			MethodName: "SetRuleSchedule",
+			Handler:    _RuleAdminService_SetRuleSchedule_Handler,
+		},
+		{
+			MethodName: "SetRuleVariantSchema",
+			Handler:    _RuleAdminService_SetRuleVariantSchema_Handler,
+		},
+		{
+			MethodName: "SetRuleBanditConfig",
+			Handler:    _RuleAdminService_SetRuleBanditConfig_Handler,
+		},
+		{
+			MethodName: "SetRuleTargeting",
+			Handler:    _RuleAdminService_SetRuleTargeting_Handler,
+		},
+		{
+			MethodName: "SetRuleLayer",
+			Handler:    _RuleAdminService_SetRuleLayer_Handler,
+		},
+		{
+			MethodName: "ListRuleRevisions",
+			Handler:    _RuleAdminService_ListRuleRevisions_Handler,
+		},
+		{
+			MethodName: "GetRuleRevision",
+			Handler:    _RuleAdminService_GetRuleRevision_Handler,
+		},
+		{
+			MethodName: "DiffRuleRevisions",
+			Handler:    _RuleAdminService_DiffRuleRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackRule",
+			Handler:    _RuleAdminService_RollbackRule_Handler,
+		},
+		{
+			MethodName: "SetRuleProtected",
+			Handler:    _RuleAdminService_SetRuleProtected_Handler,
+		},
+		{
+			MethodName: "MigrateRuleBandit",
+			Handler:    _RuleAdminService_MigrateRuleBandit_Handler,
+		},
+		{
+			MethodName: "ResetStatistics",
+			Handler:    _RuleAdminService_ResetStatistics_Handler,
+		},
+		{
+			MethodName: "CloneRule",
+			Handler:    _RuleAdminService_CloneRule_Handler,
+		},
+		{
+			MethodName: "CreateRuleTemplate",
+			Handler:    _RuleAdminService_CreateRuleTemplate_Handler,
+		},
+		{
+			MethodName: "GetRuleTemplate",
+			Handler:    _RuleAdminService_GetRuleTemplate_Handler,
+		},
+		{
+			MethodName: "ListRuleTemplates",
+			Handler:    _RuleAdminService_ListRuleTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteRuleTemplate",
+			Handler:    _RuleAdminService_DeleteRuleTemplate_Handler,
+		},
+		{
+			MethodName: "InstantiateRuleTemplate",
+			Handler:    _RuleAdminService_InstantiateRuleTemplate_Handler,
+		},
+		{
+			MethodName: "ExportRules",
+			Handler:    _RuleAdminService_ExportRules_Handler,
+		},
+		{
+			MethodName: "ImportRules",
+			Handler:    _RuleAdminService_ImportRules_Handler,
+		},
+		{
+			MethodName: "CreateLayer",
+			Handler:    _RuleAdminService_CreateLayer_Handler,
+		},
+		{
+			MethodName: "GetLayer",
+			Handler:    _RuleAdminService_GetLayer_Handler,
+		},
 		{
 			MethodName: "GetVariant",
 			Handler:    _RuleAdminService_GetVariant_Handler,
@@ -536,10 +2183,38 @@ var RuleAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddVariant",
 			Handler:    _RuleAdminService_AddVariant_Handler,
 		},
+		{
+			MethodName: "UpdateVariant",
+			Handler:    _RuleAdminService_UpdateVariant_Handler,
+		},
+		{
+			MethodName: "DeleteVariant",
+			Handler:    _RuleAdminService_DeleteVariant_Handler,
+		},
 		{
 			MethodName: "SetVariantState",
 			Handler:    _RuleAdminService_SetVariantState_Handler,
 		},
+		{
+			MethodName: "SetVariantSchedule",
+			Handler:    _RuleAdminService_SetVariantSchedule_Handler,
+		},
+		{
+			MethodName: "AddGuardrail",
+			Handler:    _RuleAdminService_AddGuardrail_Handler,
+		},
+		{
+			MethodName: "RemoveGuardrail",
+			Handler:    _RuleAdminService_RemoveGuardrail_Handler,
+		},
+		{
+			MethodName: "GetRuleGuardrails",
+			Handler:    _RuleAdminService_GetRuleGuardrails_Handler,
+		},
+		{
+			MethodName: "ListGuardrails",
+			Handler:    _RuleAdminService_ListGuardrails_Handler,
+		},
 		{
 			MethodName: "CreateWantedBandit",
 			Handler:    _RuleAdminService_CreateWantedBandit_Handler,
@@ -548,6 +2223,70 @@ var RuleAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWantedRegistry",
 			Handler:    _RuleAdminService_GetWantedRegistry_Handler,
 		},
+		{
+			MethodName: "GetWantedBandit",
+			Handler:    _RuleAdminService_GetWantedBandit_Handler,
+		},
+		{
+			MethodName: "UpdateWantedBandit",
+			Handler:    _RuleAdminService_UpdateWantedBandit_Handler,
+		},
+		{
+			MethodName: "DeprecateWantedBandit",
+			Handler:    _RuleAdminService_DeprecateWantedBandit_Handler,
+		},
+		{
+			MethodName: "ListStaleBanditRules",
+			Handler:    _RuleAdminService_ListStaleBanditRules_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _RuleAdminService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "CreateTenant",
+			Handler:    _RuleAdminService_CreateTenant_Handler,
+		},
+		{
+			MethodName: "UpdateTenant",
+			Handler:    _RuleAdminService_UpdateTenant_Handler,
+		},
+		{
+			MethodName: "SetTenantServices",
+			Handler:    _RuleAdminService_SetTenantServices_Handler,
+		},
+		{
+			MethodName: "GetTenant",
+			Handler:    _RuleAdminService_GetTenant_Handler,
+		},
+		{
+			MethodName: "ListTenants",
+			Handler:    _RuleAdminService_ListTenants_Handler,
+		},
+		{
+			MethodName: "ListChangeRequests",
+			Handler:    _RuleAdminService_ListChangeRequests_Handler,
+		},
+		{
+			MethodName: "GetChangeRequest",
+			Handler:    _RuleAdminService_GetChangeRequest_Handler,
+		},
+		{
+			MethodName: "SubmitChangeRequest",
+			Handler:    _RuleAdminService_SubmitChangeRequest_Handler,
+		},
+		{
+			MethodName: "ApproveChangeRequest",
+			Handler:    _RuleAdminService_ApproveChangeRequest_Handler,
+		},
+		{
+			MethodName: "RejectChangeRequest",
+			Handler:    _RuleAdminService_RejectChangeRequest_Handler,
+		},
+		{
+			MethodName: "ApplyChangeRequest",
+			Handler:    _RuleAdminService_ApplyChangeRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rule-admin/api/admin.proto",
@@ -0,0 +1,69 @@
+package migration
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// Command runs the migrate subcommand of a service: up, down [-steps n] or status.
+func Command(ctx context.Context, m *Migrator, args []string, w io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("migrate: expected up, down or status")
+	}
+
+	switch args[0] {
+	case "up":
+		done, err := m.Up(ctx)
+		if err != nil {
+			return err
+		}
+		printDone(w, "applied", done)
+		return nil
+
+	case "down":
+		fs := flag.NewFlagSet("migrate down", flag.ContinueOnError)
+		steps := fs.Int("steps", 1, "count of migrations to revert")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		if *steps <= 0 {
+			return fmt.Errorf("migrate down: -steps must be positive")
+		}
+
+		done, err := m.Down(ctx, *steps)
+		if err != nil {
+			return err
+		}
+		printDone(w, "reverted", done)
+		return nil
+
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "VERSION\tNAME\tAPPLIED")
+		for _, s := range statuses {
+			fmt.Fprintf(tw, "%d\t%s\t%t\n", s.Version, s.Name, s.Applied)
+		}
+		return tw.Flush()
+
+	default:
+		return fmt.Errorf("migrate: unknown command %q, expected up, down or status", args[0])
+	}
+}
+
+func printDone(w io.Writer, verb string, done []Migration) {
+	if len(done) == 0 {
+		fmt.Fprintf(w, "nothing %s\n", verb)
+		return
+	}
+	for _, m := range done {
+		fmt.Fprintf(w, "%s %d_%s\n", verb, m.Version, m.Name)
+	}
+}
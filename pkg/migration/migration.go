@@ -0,0 +1,232 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var ErrUnknownVersion = errors.New("database has a migration unknown to this release")
+
+// Migration is one numbered schema change, Down is empty for irreversible ones.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Locker serializes concurrent migration runners.
+type Locker interface {
+	Lock(ctx context.Context, exec func(ctx context.Context) error) error
+}
+
+// Driver keeps the schema version table of one database. Methods are called
+// with ctx of Lock.
+type Driver interface {
+	Locker
+
+	Applied(ctx context.Context) ([]int, error)
+	Apply(ctx context.Context, m Migration) error
+	Revert(ctx context.Context, m Migration) error
+}
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Load reads migrations from files NNNN_name.up.sql and NNNN_name.down.sql of dir.
+func Load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "read migrations dir")
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+
+		parts := fileName.FindStringSubmatch(e.Name())
+		if parts == nil {
+			return nil, fmt.Errorf("unexpected migration file %s", e.Name())
+		}
+
+		version, _ := strconv.Atoi(parts[1])
+		if version <= 0 {
+			return nil, fmt.Errorf("migration %s: version must be positive", e.Name())
+		}
+
+		raw, err := fs.ReadFile(fsys, path.Join(dir, e.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "read %s", e.Name())
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: parts[2]}
+			byVersion[version] = m
+		}
+		if m.Name != parts[2] {
+			return nil, fmt.Errorf("migration %d has names %s and %s", version, m.Name, parts[2])
+		}
+
+		if parts[3] == "up" {
+			m.Up = string(raw)
+		} else {
+			m.Down = string(raw)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if len(strings.TrimSpace(m.Up)) == 0 {
+			return nil, fmt.Errorf("migration %d_%s has no up script", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	slices.SortFunc(migrations, func(a, b Migration) int { return a.Version - b.Version })
+
+	return migrations, nil
+}
+
+// Statements splits a script into statements for databases executing one at a time.
+func Statements(script string) []string {
+	var res []string
+	for _, stmt := range strings.Split(script, ";") {
+		if stmt = strings.TrimSpace(stmt); len(stmt) > 0 {
+			res = append(res, stmt)
+		}
+	}
+	return res
+}
+
+type Status struct {
+	Migration
+	Applied bool
+}
+
+type Migrator struct {
+	driver     Driver
+	migrations []Migration
+}
+
+func New(driver Driver, migrations []Migration) *Migrator {
+	return &Migrator{
+		driver:     driver,
+		migrations: migrations,
+	}
+}
+
+// Up applies all pending migrations in version order. A database migrated by a
+// newer release is left as is.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+
+	err := m.driver.Lock(ctx, func(ctx context.Context) error {
+		applied, err := m.applied(ctx)
+		if err != nil {
+			return err
+		}
+		if err := m.checkKnown(applied); err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+			if err := m.driver.Apply(ctx, mig); err != nil {
+				return errors.Wrapf(err, "apply migration %d_%s", mig.Version, mig.Name)
+			}
+			done = append(done, mig)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// Down reverts the last steps applied migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var done []Migration
+
+	err := m.driver.Lock(ctx, func(ctx context.Context) error {
+		applied, err := m.applied(ctx)
+		if err != nil {
+			return err
+		}
+		if err := m.checkKnown(applied); err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := applied[mig.Version]; !ok {
+				continue
+			}
+			if len(strings.TrimSpace(mig.Down)) == 0 {
+				return fmt.Errorf("migration %d_%s is irreversible", mig.Version, mig.Name)
+			}
+			if err := m.driver.Revert(ctx, mig); err != nil {
+				return errors.Wrapf(err, "revert migration %d_%s", mig.Version, mig.Name)
+			}
+			done = append(done, mig)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var res []Status
+
+	err := m.driver.Lock(ctx, func(ctx context.Context) error {
+		applied, err := m.applied(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			_, ok := applied[mig.Version]
+			res = append(res, Status{Migration: mig, Applied: ok})
+		}
+
+		return nil
+	})
+
+	return res, err
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int]struct{}, error) {
+	versions, err := m.driver.Applied(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get applied versions")
+	}
+
+	applied := make(map[int]struct{}, len(versions))
+	for _, v := range versions {
+		applied[v] = struct{}{}
+	}
+
+	return applied, nil
+}
+
+// checkKnown fails when the database was migrated by a newer release.
+func (m *Migrator) checkKnown(applied map[int]struct{}) error {
+	for v := range applied {
+		if !slices.ContainsFunc(m.migrations, func(mig Migration) bool { return mig.Version == v }) {
+			return fmt.Errorf("%w: version %d", ErrUnknownVersion, v)
+		}
+	}
+	return nil
+}
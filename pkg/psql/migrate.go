@@ -0,0 +1,82 @@
+package psql
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/EbumbaE/bandit/pkg/migration"
+)
+
+const SchemaVersionTable = "schema_version"
+
+// MigrationDriver runs all migrations of one run in a single transaction holding
+// an advisory lock, so a failed run leaves the schema untouched and concurrent
+// runners wait for each other.
+type MigrationDriver struct {
+	db    Database
+	table string
+}
+
+func NewMigrationDriver(db Database, table string) *MigrationDriver {
+	return &MigrationDriver{
+		db:    db,
+		table: table,
+	}
+}
+
+func NewMigrator(db Database, migrations []migration.Migration) *migration.Migrator {
+	return migration.New(NewMigrationDriver(db, SchemaVersionTable), migrations)
+}
+
+func (d *MigrationDriver) Lock(ctx context.Context, exec func(ctx context.Context) error) error {
+	return d.db.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := d.db.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1));`, d.table); err != nil {
+			return errors.Wrap(err, "lock migrations")
+		}
+		return exec(ctx)
+	})
+}
+
+func (d *MigrationDriver) Applied(ctx context.Context) ([]int, error) {
+	query := `
+		CREATE TABLE IF NOT EXISTS ` + d.table + ` (
+			version INT NOT NULL PRIMARY KEY,
+			name TEXT NOT NULL,
+
+			applied_at TIMESTAMP NOT NULL DEFAULT now()
+		);
+`
+	if _, err := d.db.Exec(ctx, query); err != nil {
+		return nil, errors.Wrap(err, "create version table")
+	}
+
+	var versions []int
+	err := d.db.GetSlice(ctx, &versions, `SELECT version FROM `+d.table+` ORDER BY version;`)
+
+	return versions, err
+}
+
+func (d *MigrationDriver) Apply(ctx context.Context, m migration.Migration) error {
+	if _, err := d.db.Exec(ctx, m.Up); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO ` + d.table + ` (version, name, applied_at)
+		VALUES ($1, $2, NOW() at time zone 'utc');
+`
+	_, err := d.db.Exec(ctx, query, m.Version, m.Name)
+
+	return err
+}
+
+func (d *MigrationDriver) Revert(ctx context.Context, m migration.Migration) error {
+	if _, err := d.db.Exec(ctx, m.Down); err != nil {
+		return err
+	}
+
+	_, err := d.db.Exec(ctx, `DELETE FROM `+d.table+` WHERE version = $1;`, m.Version)
+
+	return err
+}
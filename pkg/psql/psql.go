@@ -19,6 +19,7 @@ type Database interface {
 	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 
 	WrapWithTx(ctx context.Context, exec func(tx pgx.Tx) error) error
+	RunInTx(ctx context.Context, exec func(ctx context.Context) error) error
 
 	Close()
 }
@@ -27,6 +28,26 @@ type database struct {
 	conn *pgxpool.Pool
 }
 
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type txKey struct{}
+
+func txFromContext(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+	return tx, ok
+}
+
+func (d *database) querier(ctx context.Context) querier {
+	if tx, ok := txFromContext(ctx); ok {
+		return tx
+	}
+	return d.conn
+}
+
 func NewDatabase(ctx context.Context, dsn string) (Database, error) {
 	conn, err := pgxpool.New(ctx, dsn)
 	if err != nil {
@@ -41,7 +62,7 @@ func NewDatabase(ctx context.Context, dsn string) (Database, error) {
 func (d *database) GetSingle(ctx context.Context, pointerOnDst any, query string, args ...any) error {
 	err := pgxscan.Get(
 		ctx,
-		d.conn,
+		d.querier(ctx),
 		pointerOnDst,
 		query,
 		args...,
@@ -52,7 +73,7 @@ func (d *database) GetSingle(ctx context.Context, pointerOnDst any, query string
 func (d *database) GetSlice(ctx context.Context, pointerOnSliceDst any, query string, args ...any) error {
 	err := pgxscan.Select(
 		ctx,
-		d.conn,
+		d.querier(ctx),
 		pointerOnSliceDst,
 		query,
 		args...,
@@ -61,15 +82,18 @@ func (d *database) GetSlice(ctx context.Context, pointerOnSliceDst any, query st
 }
 
 func (d *database) Exec(ctx context.Context, query string, args ...any) (commandTag pgconn.CommandTag, err error) {
-	return d.conn.Exec(ctx, query, args...)
+	return d.querier(ctx).Exec(ctx, query, args...)
 }
 
 func (d *database) Begin(ctx context.Context) (pgx.Tx, error) {
+	if tx, ok := txFromContext(ctx); ok {
+		return tx.Begin(ctx)
+	}
 	return d.conn.Begin(ctx)
 }
 
 func (d *database) WrapWithTx(ctx context.Context, exec func(tx pgx.Tx) error) error {
-	tx, err := d.conn.Begin(ctx)
+	tx, err := d.Begin(ctx)
 	if err != nil {
 		return errors.Wrapf(err, "begin")
 	}
@@ -88,12 +112,24 @@ func (d *database) WrapWithTx(ctx context.Context, exec func(tx pgx.Tx) error) e
 	return nil
 }
 
+// RunInTx executes exec with a transaction carried in ctx, so every call made
+// through the database with that ctx joins it. Nested calls reuse the outer transaction.
+func (d *database) RunInTx(ctx context.Context, exec func(ctx context.Context) error) error {
+	if _, ok := txFromContext(ctx); ok {
+		return exec(ctx)
+	}
+
+	return d.WrapWithTx(ctx, func(tx pgx.Tx) error {
+		return exec(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
 func (d *database) Query(ctx context.Context, query string, args ...any) (pgx.Rows, error) {
-	return d.conn.Query(ctx, query, args...)
+	return d.querier(ctx).Query(ctx, query, args...)
 }
 
 func (d *database) QueryRow(ctx context.Context, query string, args ...any) pgx.Row {
-	return d.conn.QueryRow(ctx, query, args...)
+	return d.querier(ctx).QueryRow(ctx, query, args...)
 }
 
 func (d *database) Close() {
@@ -16,6 +16,12 @@ const (
 	DefaultExplorationFactor = 0.1
 )
 
+// Implemented reports whether the bandit key has an algorithm in this package,
+// bandits can only be migrated to such keys.
+func Implemented(banditKey string) bool {
+	return banditKey == GaussianBanditKey
+}
+
 func init() {
 	rand.Seed(uint64(time.Now().UnixNano()))
 }
@@ -61,6 +67,37 @@ func NewDefaultGaussianArm() *GaussianArm {
 	}
 }
 
+// ArmStats is the algorithm independent summary of an arm, used to carry
+// learned state over when a rule switches to another algorithm.
+type ArmStats struct {
+	Count    uint64
+	Mean     float64
+	Variance float64
+}
+
+func (ga *GaussianArm) Stats() ArmStats {
+	return ArmStats{Count: ga.Count, Mean: ga.Mu, Variance: ga.SigmaSq}
+}
+
+// NewGaussianArmFromStats restores an arm from statistics: the inverse gamma prior
+// is centered on the variance and its weight grows with the count.
+func NewGaussianArmFromStats(stats ArmStats) *GaussianArm {
+	arm := NewDefaultGaussianArm()
+	if stats.Count == 0 {
+		return arm
+	}
+
+	arm.Count = stats.Count
+	arm.Mu = stats.Mean
+	if stats.Variance > 0 {
+		arm.SigmaSq = stats.Variance
+	}
+	arm.Alpha += float64(stats.Count) / 2
+	arm.Beta = arm.SigmaSq * (arm.Alpha - 1.0)
+
+	return arm
+}
+
 func (gb *GaussianBandit) Calculate(params *GaussianArm, reward float64, count uint64) *GaussianArm {
 	newParams := *params
 
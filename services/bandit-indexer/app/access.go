@@ -0,0 +1,16 @@
+package app
+
+import (
+	"github.com/EbumbaE/bandit/pkg/auth"
+	desc "github.com/EbumbaE/bandit/pkg/genproto/bandit-indexer/api"
+)
+
+var MethodRoles = map[string]auth.Role{
+	desc.BanditIndexerService_GetRuleScores_FullMethodName:  auth.RoleViewer,
+	desc.BanditIndexerService_GetArmHistory_FullMethodName:  auth.RoleViewer,
+	desc.BanditIndexerService_CreateSnapshot_FullMethodName: auth.RoleViewer,
+
+	desc.BanditIndexerService_RestoreSnapshot_FullMethodName: auth.RoleAdmin,
+	desc.BanditIndexerService_ResetRule_FullMethodName:       auth.RoleAdmin,
+	desc.BanditIndexerService_ResetArm_FullMethodName:        auth.RoleAdmin,
+}
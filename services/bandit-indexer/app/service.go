@@ -2,17 +2,30 @@ package app
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	desc "github.com/EbumbaE/bandit/pkg/genproto/bandit-indexer/api"
 	model "github.com/EbumbaE/bandit/services/bandit-indexer/internal"
+	"github.com/EbumbaE/bandit/services/bandit-indexer/internal/provider"
+	"github.com/EbumbaE/bandit/services/bandit-indexer/internal/snapshot"
 )
 
 type IndexerProvider interface {
 	GetBandit(ctx context.Context, ruleID string) (model.Bandit, error)
+
+	CreateSnapshot(ctx context.Context, ruleIDs []string, pageToken string, pageSize uint64) (snapshot.Snapshot, string, error)
+	RestoreSnapshot(ctx context.Context, snap snapshot.Snapshot, ruleIDs []string) ([]string, error)
+
+	GetArmHistory(ctx context.Context, ruleID, variantID string, from, to time.Time, limit uint64) ([]model.ArmHistoryPoint, error)
+
+	ResetRule(ctx context.Context, ruleID string) ([]string, error)
+	ResetArm(ctx context.Context, ruleID, variantID string) ([]string, error)
 }
 
 type Implementation struct {
@@ -46,6 +59,149 @@ func (i *Implementation) GetRuleScores(ctx context.Context, req *desc.GetRuleSco
 	}, nil
 }
 
+func (i *Implementation) CreateSnapshot(ctx context.Context, req *desc.CreateSnapshotRequest) (*desc.Snapshot, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/CreateSnapshot")
+	defer span.Finish()
+
+	snap, next, err := i.indexerProvider.CreateSnapshot(ctx, req.GetRuleIds(), req.GetPageToken(), uint64(req.GetPageSize()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	data, err := snap.Marshal()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var arms int
+	for _, b := range snap.Bandits {
+		arms += len(b.Arms)
+	}
+
+	return &desc.Snapshot{
+		CreatedAt:     timestamppb.New(snap.CreatedAt),
+		Bandits:       uint64(len(snap.Bandits)),
+		Arms:          uint64(arms),
+		Data:          data,
+		NextPageToken: next,
+	}, nil
+}
+
+func (i *Implementation) RestoreSnapshot(ctx context.Context, req *desc.RestoreSnapshotRequest) (*desc.RestoreSnapshotResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/RestoreSnapshot")
+	defer span.Finish()
+
+	if len(req.GetData()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty snapshot")
+	}
+
+	snap, err := snapshot.Unmarshal(req.GetData())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	restored, err := i.indexerProvider.RestoreSnapshot(ctx, snap, req.GetRuleIds())
+	if err != nil {
+		if errors.Is(err, snapshot.ErrInvalid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.RestoreSnapshotResponse{
+		RuleIds: restored,
+	}, nil
+}
+
+func (i *Implementation) GetArmHistory(ctx context.Context, req *desc.GetArmHistoryRequest) (*desc.GetArmHistoryResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetArmHistory")
+	defer span.Finish()
+
+	if len(req.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty rule id")
+	}
+
+	var from, to time.Time
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+
+	points, err := i.indexerProvider.GetArmHistory(ctx, req.GetRuleId(), req.GetVariantId(), from, to, req.GetLimit())
+	if err != nil {
+		if errors.Is(err, provider.ErrInvalidHistoryRange) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.GetArmHistoryResponse{
+		Points: decodeArmHistory(points),
+	}, nil
+}
+
+func (i *Implementation) ResetRule(ctx context.Context, req *desc.ResetRuleRequest) (*desc.ResetResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ResetRule")
+	defer span.Finish()
+
+	if len(req.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty rule id")
+	}
+
+	reset, err := i.indexerProvider.ResetRule(ctx, req.GetRuleId())
+	if err != nil {
+		return nil, resetError(err)
+	}
+
+	return &desc.ResetResponse{
+		VariantIds: reset,
+	}, nil
+}
+
+func (i *Implementation) ResetArm(ctx context.Context, req *desc.ResetArmRequest) (*desc.ResetResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ResetArm")
+	defer span.Finish()
+
+	if len(req.GetRuleId()) == 0 || len(req.GetVariantId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty rule id or variant id")
+	}
+
+	reset, err := i.indexerProvider.ResetArm(ctx, req.GetRuleId(), req.GetVariantId())
+	if err != nil {
+		return nil, resetError(err)
+	}
+
+	return &desc.ResetResponse{
+		VariantIds: reset,
+	}, nil
+}
+
+func resetError(err error) error {
+	if errors.Is(err, provider.ErrNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func decodeArmHistory(in []model.ArmHistoryPoint) []*desc.ArmHistoryPoint {
+	res := make([]*desc.ArmHistoryPoint, len(in))
+
+	for i, v := range in {
+		res[i] = &desc.ArmHistoryPoint{
+			VariantId:     v.VariantId,
+			BanditVersion: v.BanditVersion,
+			Count:         v.Count,
+			Mean:          v.Mean,
+			Variance:      v.Variance,
+			RecordedAt:    timestamppb.New(v.RecordedAt),
+		}
+	}
+
+	return res
+}
+
 func decodeArms(in []model.Arm) []*desc.Variant {
 	res := make([]*desc.Variant, len(in))
 
@@ -1,9 +1,16 @@
 package main
 
 import (
+	"os"
+
 	"github.com/EbumbaE/bandit/services/bandit-indexer/cmd/run"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		run.Migrate(os.Args[2:])
+		return
+	}
+
 	run.Run()
 }
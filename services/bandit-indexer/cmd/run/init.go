@@ -4,10 +4,12 @@ import (
 	"context"
 	"sync"
 
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/EbumbaE/bandit/pkg/auth"
 	rule_admin_client "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
 	"github.com/EbumbaE/bandit/pkg/kafka"
 	"github.com/EbumbaE/bandit/pkg/logger"
@@ -78,7 +80,10 @@ func newApp(ctx context.Context, cfg *Config) *application {
 }
 
 func (a *application) initClients(ctx context.Context) {
-	conn, err := grpc.DialContext(ctx, a.cfg.Service.RuleAdminAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.DialContext(ctx, a.cfg.Service.RuleAdminAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(auth.NewAPIKeyCredentials(a.cfg.Service.RuleAdminApiKey)),
+	)
 	if err != nil {
 		logger.Fatal("connect to bandit-indexer", zap.Error(err))
 	}
@@ -116,7 +121,7 @@ func (a *application) initRepos(ctx context.Context) {
 }
 
 func (a *application) initProvider() {
-	a.provider = provider.NewProvider(a.repositories.banditIndexer)
+	a.provider = provider.NewProvider(a.repositories.banditIndexer, a.notifiers.banditIndexer, a.cfg.Service.ArmHistoryInterval)
 }
 
 func (a *application) initService() {
@@ -124,7 +129,7 @@ func (a *application) initService() {
 }
 
 func (a *application) initConsumer(ctx context.Context) {
-	admin := indexer_consumer.NewAdminConsumer(a.clients.adminWrapper, a.repositories.banditIndexer, a.notifiers.banditIndexer)
+	admin := indexer_consumer.NewAdminConsumer(a.clients.adminWrapper, a.repositories.banditIndexer, a.provider, a.provider, a.notifiers.banditIndexer)
 	consumer, err := kafka.NewKafkaConsumer(ctx, a.cfg.Kafka.Brokers, a.cfg.Kafka.AdminTopic, admin.Handle, nil)
 	if err != nil {
 		logger.Fatal("init bandit-indexer admin consumer", zap.Error(err))
@@ -146,12 +151,35 @@ func (a *application) initConsumer(ctx context.Context) {
 }
 
 func (a *application) Run(ctx context.Context, swaggerPath string) error {
-	server.StarBanditIndexer(ctx, a.service, a.wg, a.cfg.Service.GrpcAddress)
+	var opts []grpc.ServerOption
+	if a.cfg.Auth.Enabled {
+		authn, err := a.newAuthenticator()
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor(authn, bandit_indexer_service.MethodRoles)))
+	}
+
+	server.StarBanditIndexer(ctx, a.service, a.wg, a.cfg.Service.GrpcAddress, opts...)
 	server.InitBanditIndexerSwagger(ctx, a.wg, swaggerPath, a.cfg.Service.SwaggerAddress, a.cfg.Service.SwaggerHost, a.cfg.Service.GrpcAddress)
 
 	return nil
 }
 
+func (a *application) newAuthenticator() (auth.Authenticator, error) {
+	chain := auth.Chain{auth.NewAPIKeyAuthenticator(a.cfg.Auth.APIKeys)}
+
+	if a.cfg.Auth.JWT != nil {
+		jwtAuthn, err := auth.NewJWTAuthenticator(*a.cfg.Auth.JWT)
+		if err != nil {
+			return nil, errors.Wrap(err, "init jwt authenticator")
+		}
+		chain = append(chain, jwtAuthn)
+	}
+
+	return chain, nil
+}
+
 func (a *application) Close() {
 	a.connections.db.Close()
 }
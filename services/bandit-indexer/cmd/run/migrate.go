@@ -0,0 +1,42 @@
+package run
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/EbumbaE/bandit/pkg/migration"
+	"github.com/EbumbaE/bandit/pkg/psql"
+
+	indexer_storage "github.com/EbumbaE/bandit/services/bandit-indexer/internal/storage"
+)
+
+// Migrate runs schema migrations: migrate -config path up | down [-steps n] | status.
+func Migrate(args []string) {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	configPath := fs.String("config", "", "config path")
+	_ = fs.Parse(args)
+
+	config := readConfig(*configPath)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	db, err := psql.NewDatabase(ctx, config.Postgres.Dsn)
+	if err != nil {
+		log.Fatal("connect to postgres: ", err)
+	}
+	defer db.Close()
+
+	m, err := indexer_storage.NewMigrator(db)
+	if err != nil {
+		log.Fatal("load migrations: ", err)
+	}
+
+	if err := migration.Command(ctx, m, fs.Args(), os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
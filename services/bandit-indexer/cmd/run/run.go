@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v2"
 
+	"github.com/EbumbaE/bandit/pkg/auth"
 	"github.com/EbumbaE/bandit/pkg/logger"
 )
 
@@ -62,6 +63,7 @@ type Config struct {
 	Service  RuleAdminService `yaml:"service"`
 	Postgres Postgres         `yaml:"postgres"`
 	Kafka    Kafka            `yaml:"kafka"`
+	Auth     Auth             `yaml:"auth"`
 }
 
 type RuleAdminService struct {
@@ -69,7 +71,10 @@ type RuleAdminService struct {
 	SwaggerHost       string        `yaml:"swagger_host"`
 	GrpcAddress       string        `yaml:"bandit_indexer_address"`
 	RuleAdminAddress  string        `yaml:"rule_admin_adress"`
+	RuleAdminApiKey   string        `yaml:"rule_admin_api_key"`
 	ConnectionTimeout time.Duration `yaml:"connection_timeout"`
+
+	ArmHistoryInterval time.Duration `yaml:"arm_history_interval"`
 }
 
 type Postgres struct {
@@ -82,3 +87,9 @@ type Kafka struct {
 	AnalyticTopic string   `yaml:"analytic_topic"`
 	IndexerTopic  string   `yaml:"indexer_topic"`
 }
+
+type Auth struct {
+	Enabled bool            `yaml:"enabled"`
+	APIKeys []auth.APIKey   `yaml:"api_keys"`
+	JWT     *auth.JWTConfig `yaml:"jwt"`
+}
@@ -0,0 +1,73 @@
+package algorithm
+
+import (
+	core "github.com/EbumbaE/bandit/services/bandit-core/v6"
+	"github.com/pkg/errors"
+)
+
+// Algorithm serializes bandit and arm state of one bandit implementation.
+// NewBandit and NewArm take the rule bandit config, its fields override the
+// algorithm defaults, an empty config keeps them.
+type Algorithm interface {
+	Key() string
+
+	NewBandit(config []byte) ([]byte, error)
+	NewArm(config []byte) ([]byte, error)
+
+	ArmStats(config []byte) (core.ArmStats, error)
+	ArmFromStats(stats core.ArmStats) ([]byte, error)
+}
+
+var algorithms = map[string]Algorithm{
+	core.GaussianBanditKey: gaussian{},
+}
+
+// Get returns the implementation of the bandit key. Keys without their own
+// implementation run on the gaussian one.
+func Get(banditKey string) Algorithm {
+	if a, ok := algorithms[banditKey]; ok {
+		return a
+	}
+	return algorithms[core.GaussianBanditKey]
+}
+
+type gaussian struct{}
+
+func (gaussian) Key() string {
+	return core.GaussianBanditKey
+}
+
+func (gaussian) NewBandit(config []byte) ([]byte, error) {
+	bandit := core.NewDefaultGaussianBandit()
+	if len(config) > 0 {
+		if err := bandit.Deserialize(config); err != nil {
+			return nil, errors.Wrap(err, "gaussian bandit config")
+		}
+		bandit.Version = 1
+	}
+	return bandit.Serialize()
+}
+
+// NewArm takes the prior from the config, the learned counters always start from zero.
+func (gaussian) NewArm(config []byte) ([]byte, error) {
+	arm := core.NewDefaultGaussianArm()
+	if len(config) > 0 {
+		if err := arm.Deserialize(config); err != nil {
+			return nil, errors.Wrap(err, "gaussian arm config")
+		}
+		arm.Count, arm.TimeCount, arm.Version = 0, 0, 0
+	}
+	return arm.Serialize()
+}
+
+func (gaussian) ArmStats(config []byte) (core.ArmStats, error) {
+	arm := core.NewDefaultGaussianArm()
+	if err := arm.Deserialize(config); err != nil {
+		return core.ArmStats{}, errors.Wrap(err, "gaussian arm")
+	}
+	return arm.Stats(), nil
+}
+
+func (gaussian) ArmFromStats(stats core.ArmStats) ([]byte, error) {
+	return core.NewGaussianArmFromStats(stats).Serialize()
+}
@@ -34,10 +34,11 @@ func (i *AdminWrapper) GetBandit(ctx context.Context, ruleID string) (model.Band
 	}
 
 	return model.Bandit{
-		RuleId:    rule.GetRule().GetId(),
-		BanditKey: rule.GetRule().GetBanditKey(),
-		State:     decodeStateType(rule.GetRule().GetState()),
-		Arms:      decodeArms(rule.GetRule().GetVariants()),
+		RuleId:     rule.GetRule().GetId(),
+		BanditKey:  rule.GetRule().GetBanditKey(),
+		State:      decodeStateType(rule.GetRule().GetState()),
+		RuleConfig: decodeConfig(rule.GetRule().GetBanditConfig()),
+		Arms:       decodeArms(rule.GetRule().GetVariants()),
 	}, nil
 }
 
@@ -83,6 +84,14 @@ func decodeArm(v *pb.Variant) model.Arm {
 	}
 }
 
+// decodeConfig keeps an empty config NULL, it is not valid json.
+func decodeConfig(config string) []byte {
+	if len(config) == 0 {
+		return nil
+	}
+	return []byte(config)
+}
+
 func decodeStateType(state pb.State) model.StateType {
 	switch state {
 	case pb.State_STATE_ENABLED:
@@ -4,15 +4,18 @@ import (
 	"context"
 	"encoding/json"
 
-	core "github.com/EbumbaE/bandit/services/bandit-core/v6"
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 
+	"github.com/EbumbaE/bandit/pkg/logger"
 	model "github.com/EbumbaE/bandit/services/bandit-indexer/internal"
+	"github.com/EbumbaE/bandit/services/bandit-indexer/internal/algorithm"
 	"github.com/EbumbaE/bandit/services/bandit-indexer/internal/storage"
 )
 
 type Notifier interface {
 	Send(ctx context.Context, ruleID string) error
+	SendDelete(ctx context.Context, ruleID string) error
 }
 
 type Admin interface {
@@ -27,24 +30,40 @@ type Admin interface {
 
 type Storage interface {
 	CreateBandit(ctx context.Context, bandit model.Bandit) (model.Bandit, error)
+	GetBandit(ctx context.Context, ruleID string) (model.Bandit, error)
+	SetBanditRuleConfig(ctx context.Context, ruleID string, config []byte) error
 	SetBanditState(ctx context.Context, ruleID string, state model.StateType) error
 	DeleteBandit(ctx context.Context, ruleID string) error
 
+	GetArm(ctx context.Context, variantID string) (model.Arm, error)
+	GetAllArms(ctx context.Context, ruleID string) ([]model.Arm, error)
 	AddArm(ctx context.Context, ruleID string, v model.Arm) (model.Arm, error)
 	SetArmState(ctx context.Context, variantID string, state model.StateType) error
 	DeleteArm(ctx context.Context, variantID string) error
 }
 
+type Resetter interface {
+	ResetArms(ctx context.Context, ruleID string, variantIDs []string) ([]string, error)
+}
+
+type Migrator interface {
+	MigrateBandit(ctx context.Context, bandit model.Bandit) error
+}
+
 type AdminConsumer struct {
 	storage  Storage
 	admin    Admin
+	resetter Resetter
+	migrator Migrator
 	notifier Notifier
 }
 
-func NewAdminConsumer(admin Admin, storage Storage, notifier Notifier) *AdminConsumer {
+func NewAdminConsumer(admin Admin, storage Storage, resetter Resetter, migrator Migrator, notifier Notifier) *AdminConsumer {
 	return &AdminConsumer{
 		admin:    admin,
 		storage:  storage,
+		resetter: resetter,
+		migrator: migrator,
 		notifier: notifier,
 	}
 }
@@ -54,6 +73,8 @@ type AdminEvent struct {
 	Action    string `json:"action"`
 	RuleID    string `json:"rule_id"`
 	VariantID string `json:"variant_id"`
+
+	SourceVariantID string `json:"source_variant_id"`
 }
 
 func (c *AdminConsumer) Handle(ctx context.Context, msg []byte) error {
@@ -67,12 +88,16 @@ func (c *AdminConsumer) Handle(ctx context.Context, msg []byte) error {
 	case "rule":
 		err = c.ruleAction(ctx, event.Action, event.RuleID)
 	case "variant":
-		err = c.variantAction(ctx, event.Action, event.RuleID, event.VariantID)
+		err = c.variantAction(ctx, event.Action, event.RuleID, event.VariantID, event.SourceVariantID)
 	}
 	if err != nil {
 		return errors.Wrapf(err, "exec action[%v]", event)
 	}
 
+	if event.Type == "rule" && event.Action == "delete" {
+		return c.notifier.SendDelete(ctx, event.RuleID)
+	}
+
 	return c.notifier.Send(ctx, event.RuleID)
 }
 
@@ -84,19 +109,33 @@ func (c *AdminConsumer) ruleAction(ctx context.Context, action string, ruleID st
 			return errors.Wrap(err, "admin.GetBandit")
 		}
 
-		banditCore := core.NewDefaultGaussianBandit()
-		bandit.Config, err = banditCore.Serialize()
+		bandit.Config, err = algorithm.Get(bandit.BanditKey).NewBandit(bandit.RuleConfig)
 		if err != nil {
-			return errors.Wrap(err, "banditCore.Serialize")
+			return errors.Wrap(err, "algorithm.NewBandit")
 		}
 
 		if _, err = c.storage.CreateBandit(ctx, bandit); err != nil {
 			return errors.Wrap(err, "storage.CreateBandit")
 		}
 
+	case "update":
+		if err := c.updateRuleConfig(ctx, ruleID); err != nil {
+			return errors.Wrap(err, "updateRuleConfig")
+		}
+
+	case "migrate":
+		if err := c.migrateBandit(ctx, ruleID); err != nil {
+			return errors.Wrap(err, "migrateBandit")
+		}
+
+	case "reset":
+		if err := c.resetArms(ctx, ruleID, nil); err != nil {
+			return errors.Wrap(err, "resetArms")
+		}
+
 	case "delete":
 		if err := c.storage.DeleteBandit(ctx, ruleID); err != nil {
-			return errors.Wrap(err, "storage.CreateBandit")
+			return errors.Wrap(err, "storage.DeleteBandit")
 		}
 
 	case "inactive":
@@ -113,22 +152,35 @@ func (c *AdminConsumer) ruleAction(ctx context.Context, action string, ruleID st
 	return nil
 }
 
-func (c *AdminConsumer) variantAction(ctx context.Context, action string, ruleID, variantID string) error {
+func (c *AdminConsumer) variantAction(ctx context.Context, action string, ruleID, variantID, sourceVariantID string) error {
 	switch action {
 	case "create":
 		arm, err := c.admin.GetArm(ctx, ruleID, variantID)
 		if err != nil && !errors.Is(err, storage.ErrNotFound) {
-			return errors.Wrap(err, "admin.GetBandit")
+			return errors.Wrap(err, "admin.GetArm")
 		}
 
-		armCore := core.NewDefaultGaussianArm()
-		arm.Config, err = armCore.Serialize()
+		source, err := c.sourceArm(ctx, sourceVariantID)
 		if err != nil {
-			return errors.Wrap(err, "armCore.Serialize")
+			return err
+		}
+
+		if len(source.Config) > 0 {
+			arm.Config, arm.Count = source.Config, source.Count
+		} else {
+			arm.Config, err = c.newArm(ctx, ruleID)
+			if err != nil {
+				return err
+			}
 		}
 
 		if _, err = c.storage.AddArm(ctx, ruleID, arm); err != nil {
-			return errors.Wrap(err, "storage.CreateBandit")
+			return errors.Wrap(err, "storage.AddArm")
+		}
+
+	case "reset":
+		if err := c.resetArms(ctx, ruleID, []string{variantID}); err != nil {
+			return errors.Wrap(err, "resetArms")
 		}
 
 	case "delete":
@@ -149,3 +201,122 @@ func (c *AdminConsumer) variantAction(ctx context.Context, action string, ruleID
 
 	return nil
 }
+
+// newArm returns the prior arm of the rule bandit, the algorithm defaults when the
+// bandit is not created yet.
+func (c *AdminConsumer) newArm(ctx context.Context, ruleID string) ([]byte, error) {
+	bandit, err := c.storage.GetBandit(ctx, ruleID)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, errors.Wrap(err, "storage.GetBandit")
+	}
+
+	config, err := algorithm.Get(bandit.BanditKey).NewArm(bandit.RuleConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "algorithm.NewArm")
+	}
+
+	return config, nil
+}
+
+// updateRuleConfig keeps the rule bandit config used as the prior of new and
+// reset arms, learned statistics are left as is.
+func (c *AdminConsumer) updateRuleConfig(ctx context.Context, ruleID string) error {
+	rule, err := c.admin.GetBandit(ctx, ruleID)
+	if err != nil {
+		return errors.Wrap(err, "admin.GetBandit")
+	}
+
+	if err := c.storage.SetBanditRuleConfig(ctx, ruleID, rule.RuleConfig); err != nil {
+		return errors.Wrap(err, "storage.SetBanditRuleConfig")
+	}
+
+	return nil
+}
+
+// sourceArm returns the arm whose statistics a cloned variant inherits, an empty
+// arm when there is no source or it is already gone.
+func (c *AdminConsumer) sourceArm(ctx context.Context, sourceVariantID string) (model.Arm, error) {
+	if len(sourceVariantID) == 0 {
+		return model.Arm{}, nil
+	}
+
+	arm, err := c.storage.GetArm(ctx, sourceVariantID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.Arm{}, nil
+		}
+		return model.Arm{}, errors.Wrap(err, "storage.GetArm")
+	}
+
+	return arm, nil
+}
+
+// resetArms skips arms deleted before the reset event arrived, there is nothing to reset.
+func (c *AdminConsumer) resetArms(ctx context.Context, ruleID string, variantIDs []string) error {
+	_, err := c.resetter.ResetArms(ctx, ruleID, variantIDs)
+	if errors.Is(err, storage.ErrNotFound) {
+		logger.Info("reset of missing arms skipped", zap.String("rule_id", ruleID), zap.Strings("variant_ids", variantIDs))
+		return nil
+	}
+	return err
+}
+
+// migrateBandit switches the bandit to the algorithm of the rule bandit key. Arm
+// statistics are converted through core.ArmStats, arms whose config can't be read
+// start from the prior of the rule bandit config.
+func (c *AdminConsumer) migrateBandit(ctx context.Context, ruleID string) error {
+	rule, err := c.admin.GetBandit(ctx, ruleID)
+	if err != nil {
+		return errors.Wrap(err, "admin.GetBandit")
+	}
+
+	bandit, err := c.storage.GetBandit(ctx, ruleID)
+	if err != nil {
+		return errors.Wrap(err, "storage.GetBandit")
+	}
+	if bandit.BanditKey == rule.BanditKey {
+		return nil
+	}
+
+	from, to := algorithm.Get(bandit.BanditKey), algorithm.Get(rule.BanditKey)
+
+	bandit.BanditKey, bandit.RuleConfig = rule.BanditKey, rule.RuleConfig
+	bandit.Config, err = to.NewBandit(rule.RuleConfig)
+	if err != nil {
+		return errors.Wrap(err, "algorithm.NewBandit")
+	}
+
+	bandit.Arms, err = c.storage.GetAllArms(ctx, ruleID)
+	if err != nil {
+		return errors.Wrap(err, "storage.GetAllArms")
+	}
+
+	// the same implementation keeps arms as is
+	if from.Key() != to.Key() {
+		for i, arm := range bandit.Arms {
+			stats, err := from.ArmStats(arm.Config)
+			if err != nil {
+				logger.Error("reset arm on bandit migration", zap.String("variant_id", arm.VariantId), zap.Error(err))
+
+				bandit.Arms[i].Config, err = to.NewArm(rule.RuleConfig)
+				if err != nil {
+					return errors.Wrap(err, "algorithm.NewArm")
+				}
+				bandit.Arms[i].Count = 0
+				continue
+			}
+
+			bandit.Arms[i].Config, err = to.ArmFromStats(stats)
+			if err != nil {
+				return errors.Wrap(err, "algorithm.ArmFromStats")
+			}
+			bandit.Arms[i].Count = stats.Count
+		}
+	}
+
+	if err := c.migrator.MigrateBandit(ctx, bandit); err != nil {
+		return errors.Wrap(err, "migrator.MigrateBandit")
+	}
+
+	return nil
+}
@@ -1,11 +1,16 @@
 package internal
 
+import "time"
+
 type Bandit struct {
 	RuleId    string    `db:"rule_id"`
 	Version   uint64    `db:"version"`
 	Config    []byte    `db:"config"`
 	BanditKey string    `db:"bandit_key"`
 	State     StateType `db:"state"`
+	// RuleConfig is the bandit config of the rule in rule-admin, it overrides
+	// the algorithm defaults of new bandits and arms.
+	RuleConfig []byte `db:"rule_config"`
 
 	Arms []Arm
 }
@@ -25,3 +30,16 @@ var (
 	StateTypeEnable  StateType = "enabled"
 	StateTypeDisable StateType = "disabled"
 )
+
+// ArmHistoryPoint is a recorded state of an arm, Config keeps the raw parameters
+// of the bandit implementation.
+type ArmHistoryPoint struct {
+	VariantId     string    `db:"variant_id"`
+	RuleId        string    `db:"rule_id"`
+	BanditVersion uint64    `db:"bandit_version"`
+	Count         uint64    `db:"count"`
+	Mean          float64   `db:"mean"`
+	Variance      float64   `db:"variance"`
+	Config        []byte    `db:"config"`
+	RecordedAt    time.Time `db:"recorded_at"`
+}
@@ -21,14 +21,20 @@ func NewNotifier(producer Producer) *Notifier {
 
 type Event struct {
 	RuleID string `json:"rule_id"`
+	Action string `json:"action,omitempty"`
 }
 
 func (n *Notifier) Send(ctx context.Context, ruleID string) error {
-	msg, err := json.Marshal(
-		Event{
-			RuleID: ruleID,
-		},
-	)
+	return n.send(ctx, Event{RuleID: ruleID})
+}
+
+func (n *Notifier) SendDelete(ctx context.Context, ruleID string) error {
+	return n.send(ctx, Event{RuleID: ruleID, Action: "delete"})
+}
+
+func (n *Notifier) send(ctx context.Context, event Event) error {
+	ruleID := event.RuleID
+	msg, err := json.Marshal(event)
 	if err != nil {
 		return errors.Wrapf(err, "event for rule '%s' marshal", ruleID)
 	}
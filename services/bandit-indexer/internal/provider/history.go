@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/EbumbaE/bandit/pkg/logger"
+	model "github.com/EbumbaE/bandit/services/bandit-indexer/internal"
+	"github.com/EbumbaE/bandit/services/bandit-indexer/internal/algorithm"
+)
+
+const (
+	defaultHistoryPeriod = 24 * time.Hour
+	defaultHistoryLimit  = 1000
+	maxHistoryLimit      = 10000
+)
+
+var ErrInvalidHistoryRange = errors.New("invalid history range")
+
+// GetArmHistory returns the trajectory of the rule arms in [from, to), the last
+// day for zero bounds. Over limit points the newest are returned.
+func (p *Provider) GetArmHistory(ctx context.Context, ruleID, variantID string, from, to time.Time, limit uint64) ([]model.ArmHistoryPoint, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/GetArmHistory")
+	defer span.Finish()
+
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultHistoryPeriod)
+	}
+	if !from.Before(to) {
+		return nil, errors.Wrapf(ErrInvalidHistoryRange, "from %s is not before to %s", from, to)
+	}
+
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	}
+	limit = min(limit, maxHistoryLimit)
+
+	points, err := p.storage.GetArmHistory(ctx, ruleID, variantID, from.UTC(), to.UTC(), limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "storage.GetArmHistory")
+	}
+
+	return points, nil
+}
+
+// MigrateBandit stores the bandit converted to another algorithm and records
+// the converted arms, the history would otherwise jump at the next reward.
+func (p *Provider) MigrateBandit(ctx context.Context, bandit model.Bandit) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/MigrateBandit")
+	defer span.Finish()
+
+	if err := p.storage.MigrateBandit(ctx, bandit); err != nil {
+		return errors.Wrap(err, "storage.MigrateBandit")
+	}
+
+	bandit.Version++
+	p.recordBanditHistory(ctx, bandit)
+
+	return nil
+}
+
+// recordBanditHistory records every arm of the bandit regardless of the
+// interval, bandit.Version is the version the arms are stored with.
+func (p *Provider) recordBanditHistory(ctx context.Context, bandit model.Bandit) {
+	alg := algorithm.Get(bandit.BanditKey)
+	for _, arm := range bandit.Arms {
+		stats, err := alg.ArmStats(arm.Config)
+		if err != nil {
+			logger.Error("record arm history", zap.String("variant_id", arm.VariantId), zap.Error(err))
+			continue
+		}
+
+		p.recordArmHistory(ctx, model.ArmHistoryPoint{
+			VariantId:     arm.VariantId,
+			RuleId:        bandit.RuleId,
+			BanditVersion: bandit.Version,
+			Count:         arm.Count,
+			Mean:          stats.Mean,
+			Variance:      stats.Variance,
+			Config:        arm.Config,
+		}, 0)
+	}
+}
+
+// recordArmHistory keeps at most one point per arm in interval. The arm is
+// already stored, so a failed write is only logged.
+func (p *Provider) recordArmHistory(ctx context.Context, point model.ArmHistoryPoint, interval time.Duration) {
+	if err := p.storage.AddArmHistory(ctx, point, interval); err != nil {
+		logger.Error("record arm history", zap.String("variant_id", point.VariantId), zap.Error(err))
+	}
+}
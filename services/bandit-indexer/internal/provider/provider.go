@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"time"
 
 	core "github.com/EbumbaE/bandit/services/bandit-core/v6"
 	"github.com/pkg/errors"
@@ -11,21 +12,43 @@ import (
 )
 
 type Storage interface {
+	RunInTx(ctx context.Context, exec func(ctx context.Context) error) error
+
 	GetBanditByRuleID(ctx context.Context, ruleID string) (model.Bandit, error)
+	GetBanditForUpdate(ctx context.Context, ruleID string) (model.Bandit, error)
 	GetArms(ctx context.Context, ruleID string) ([]model.Arm, error)
 	GetArm(ctx context.Context, variantID string) (model.Arm, error)
 
 	UpdateArm(ctx context.Context, variantID string, config []byte, count uint64) error
 	UpBanditVersion(ctx context.Context, ruleID string) error
+
+	ExportBandits(ctx context.Context, ruleIDs []string, after string, limit uint64) ([]model.Bandit, error)
+	RestoreBandits(ctx context.Context, bandits []model.Bandit) ([]model.Bandit, error)
+	MigrateBandit(ctx context.Context, bandit model.Bandit) error
+
+	AddArmHistory(ctx context.Context, point model.ArmHistoryPoint, interval time.Duration) error
+	GetArmHistory(ctx context.Context, ruleID, variantID string, from, to time.Time, limit uint64) ([]model.ArmHistoryPoint, error)
+
+	GetBandit(ctx context.Context, ruleID string) (model.Bandit, error)
+	ResetArms(ctx context.Context, ruleID string, variantIDs []string, config []byte) ([]string, error)
+}
+
+type Notifier interface {
+	Send(ctx context.Context, ruleID string) error
 }
 
 type Provider struct {
-	storage Storage
+	storage  Storage
+	notifier Notifier
+
+	historyInterval time.Duration
 }
 
-func NewProvider(storage Storage) *Provider {
+func NewProvider(storage Storage, notifier Notifier, historyInterval time.Duration) *Provider {
 	return &Provider{
-		storage: storage,
+		storage:         storage,
+		notifier:        notifier,
+		historyInterval: historyInterval,
 	}
 }
 
@@ -68,43 +91,68 @@ func (p *Provider) GetBandit(ctx context.Context, ruleID string) (model.Bandit,
 	return bandit, nil
 }
 
+// ApplyReward updates the arm in one transaction under the bandit row lock,
+// concurrent rewards and resets of the rule arms are applied one by one.
 func (p *Provider) ApplyReward(ctx context.Context, event consumer.AnalyticEvent) error {
-	bandit, err := p.storage.GetBanditByRuleID(ctx, event.RuleID)
-	if err != nil {
-		return errors.Wrap(err, "storage.GetBanditByRuleID")
-	}
+	var (
+		bandit  model.Bandit
+		arm     model.Arm
+		coreArm *core.GaussianArm
+	)
+	err := p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		bandit, err = p.storage.GetBanditForUpdate(ctx, event.RuleID)
+		if err != nil {
+			return errors.Wrap(err, "storage.GetBanditForUpdate")
+		}
 
-	arm, err := p.storage.GetArm(ctx, event.VariantID)
-	if err != nil {
-		return errors.Wrap(err, "storage.GetArm")
-	}
+		arm, err = p.storage.GetArm(ctx, event.VariantID)
+		if err != nil {
+			return errors.Wrap(err, "storage.GetArm")
+		}
 
-	coreBandit := core.NewDefaultGaussianBandit()
-	if err := coreBandit.Deserialize(bandit.Config); err != nil {
-		return errors.Wrap(err, "coreBandit.Deserialize")
-	}
-	coreBandit.Version = bandit.Version
+		coreBandit := core.NewDefaultGaussianBandit()
+		if err := coreBandit.Deserialize(bandit.Config); err != nil {
+			return errors.Wrap(err, "coreBandit.Deserialize")
+		}
+		coreBandit.Version = bandit.Version
 
-	coreArm := core.NewDefaultGaussianArm()
-	if err := coreArm.Deserialize(arm.Config); err != nil {
-		return errors.Wrap(err, "coreArm.Deserialize")
-	}
-	coreArm.Version = event.BanditVersion
+		coreArm = core.NewDefaultGaussianArm()
+		if err := coreArm.Deserialize(arm.Config); err != nil {
+			return errors.Wrap(err, "coreArm.Deserialize")
+		}
+		coreArm.Version = event.BanditVersion
 
-	coreArm = coreBandit.Calculate(coreArm, event.Reward, event.Count)
+		coreArm = coreBandit.Calculate(coreArm, event.Reward, event.Count)
 
-	arm.Config, err = coreArm.Serialize()
-	if err != nil {
-		return errors.Wrap(err, "coreArm.Serialize")
-	}
+		arm.Config, err = coreArm.Serialize()
+		if err != nil {
+			return errors.Wrap(err, "coreArm.Serialize")
+		}
 
-	if err = p.storage.UpdateArm(ctx, arm.VariantId, arm.Config, coreArm.Count); err != nil {
-		return errors.Wrap(err, "storage.SetArmConfig")
-	}
+		if err = p.storage.UpdateArm(ctx, arm.VariantId, arm.Config, coreArm.Count); err != nil {
+			return errors.Wrap(err, "storage.SetArmConfig")
+		}
 
-	if err = p.storage.UpBanditVersion(ctx, bandit.RuleId); err != nil {
-		return errors.Wrap(err, "storage.UpBanditVersion")
+		if err = p.storage.UpBanditVersion(ctx, bandit.RuleId); err != nil {
+			return errors.Wrap(err, "storage.UpBanditVersion")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
+	p.recordArmHistory(ctx, model.ArmHistoryPoint{
+		VariantId:     arm.VariantId,
+		RuleId:        bandit.RuleId,
+		BanditVersion: bandit.Version + 1,
+		Count:         coreArm.Count,
+		Mean:          coreArm.Mu,
+		Variance:      coreArm.SigmaSq,
+		Config:        arm.Config,
+	}, p.historyInterval)
+
 	return nil
 }
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/EbumbaE/bandit/pkg/logger"
+	model "github.com/EbumbaE/bandit/services/bandit-indexer/internal"
+	"github.com/EbumbaE/bandit/services/bandit-indexer/internal/algorithm"
+	"github.com/EbumbaE/bandit/services/bandit-indexer/internal/storage"
+)
+
+// ErrNotFound is storage.ErrNotFound, consumers check it without importing the provider.
+var ErrNotFound = storage.ErrNotFound
+
+// ResetRule wipes learned statistics of all rule arms and republishes scores.
+func (p *Provider) ResetRule(ctx context.Context, ruleID string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ResetRule")
+	defer span.Finish()
+
+	return p.resetAndNotify(ctx, ruleID, nil)
+}
+
+// ResetArm wipes learned statistics of one arm and republishes scores.
+func (p *Provider) ResetArm(ctx context.Context, ruleID, variantID string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ResetArm")
+	defer span.Finish()
+
+	return p.resetAndNotify(ctx, ruleID, []string{variantID})
+}
+
+func (p *Provider) resetAndNotify(ctx context.Context, ruleID string, variantIDs []string) ([]string, error) {
+	reset, err := p.ResetArms(ctx, ruleID, variantIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.notifier.Send(ctx, ruleID); err != nil {
+		return nil, errors.Wrap(err, "notifier.Send")
+	}
+
+	return reset, nil
+}
+
+// ResetArms returns arms to the prior of the rule bandit config and bumps the
+// bandit version, all rule arms for empty variantIDs. Callers publish scores.
+func (p *Provider) ResetArms(ctx context.Context, ruleID string, variantIDs []string) ([]string, error) {
+	bandit, err := p.storage.GetBandit(ctx, ruleID)
+	if err != nil {
+		return nil, errors.Wrap(err, "storage.GetBandit")
+	}
+
+	alg := algorithm.Get(bandit.BanditKey)
+	config, err := alg.NewArm(bandit.RuleConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "algorithm.NewArm")
+	}
+	stats, err := alg.ArmStats(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "algorithm.ArmStats")
+	}
+
+	reset, err := p.storage.ResetArms(ctx, ruleID, variantIDs, config)
+	if err != nil {
+		return nil, errors.Wrap(err, "storage.ResetArms")
+	}
+
+	logger.Info("arm statistics reset", zap.String("rule_id", ruleID), zap.Strings("variant_ids", reset))
+
+	for _, variantID := range reset {
+		p.recordArmHistory(ctx, model.ArmHistoryPoint{
+			VariantId:     variantID,
+			RuleId:        ruleID,
+			BanditVersion: bandit.Version + 1,
+			Count:         stats.Count,
+			Mean:          stats.Mean,
+			Variance:      stats.Variance,
+			Config:        config,
+		}, 0)
+	}
+
+	return reset, nil
+}
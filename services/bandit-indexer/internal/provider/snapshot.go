@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/EbumbaE/bandit/pkg/logger"
+	model "github.com/EbumbaE/bandit/services/bandit-indexer/internal"
+	"github.com/EbumbaE/bandit/services/bandit-indexer/internal/algorithm"
+	"github.com/EbumbaE/bandit/services/bandit-indexer/internal/snapshot"
+)
+
+const (
+	defaultSnapshotPageSize = 100
+	maxSnapshotPageSize     = 1000
+)
+
+// CreateSnapshot exports learned state of one page of the rules ordered by id,
+// all rules for empty ruleIDs. The page starts after the rule of pageToken,
+// the returned token is empty on the last page. Every page is consistent on
+// its own, pages are read at different moments.
+func (p *Provider) CreateSnapshot(ctx context.Context, ruleIDs []string, pageToken string, pageSize uint64) (snapshot.Snapshot, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/CreateSnapshot")
+	defer span.Finish()
+
+	if pageSize == 0 {
+		pageSize = defaultSnapshotPageSize
+	}
+	pageSize = min(pageSize, maxSnapshotPageSize)
+
+	bandits, err := p.storage.ExportBandits(ctx, ruleIDs, pageToken, pageSize+1)
+	if err != nil {
+		return snapshot.Snapshot{}, "", errors.Wrap(err, "storage.ExportBandits")
+	}
+
+	var next string
+	if uint64(len(bandits)) > pageSize {
+		bandits = bandits[:pageSize]
+		next = bandits[len(bandits)-1].RuleId
+	}
+
+	return snapshot.New(time.Now(), bandits), next, nil
+}
+
+// RestoreSnapshot writes learned state of the snapshot back, only the listed
+// rules for non-empty ruleIDs. Rules deleted or migrated to another bandit
+// since the snapshot are skipped, arms missing in the snapshot are kept as is.
+// Returns restored rule ids.
+func (p *Provider) RestoreSnapshot(ctx context.Context, snap snapshot.Snapshot, ruleIDs []string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/RestoreSnapshot")
+	defer span.Finish()
+
+	bandits, err := selectBandits(snap.Model(), ruleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range bandits {
+		if err := checkBanditConfigs(b); err != nil {
+			return nil, err
+		}
+	}
+
+	bandits, err = p.storage.RestoreBandits(ctx, bandits)
+	if err != nil {
+		return nil, errors.Wrap(err, "storage.RestoreBandits")
+	}
+
+	restored := make([]string, 0, len(bandits))
+	for _, b := range bandits {
+		restored = append(restored, b.RuleId)
+		p.recordBanditHistory(ctx, b)
+
+		if err := p.notifier.Send(ctx, b.RuleId); err != nil {
+			logger.Error("notify restored rule", zap.String("rule_id", b.RuleId), zap.Error(err))
+		}
+	}
+
+	return restored, nil
+}
+
+func selectBandits(bandits []model.Bandit, ruleIDs []string) ([]model.Bandit, error) {
+	if len(ruleIDs) == 0 {
+		return bandits, nil
+	}
+
+	byRule := make(map[string]model.Bandit, len(bandits))
+	for _, b := range bandits {
+		byRule[b.RuleId] = b
+	}
+
+	res := make([]model.Bandit, 0, len(ruleIDs))
+	for _, id := range ruleIDs {
+		b, ok := byRule[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: rule %s is not in snapshot", snapshot.ErrInvalid, id)
+		}
+		res = append(res, b)
+	}
+
+	return res, nil
+}
+
+// checkBanditConfigs rejects arm configs the bandit algorithm can not read,
+// otherwise the rule would fail on every score request after restore.
+func checkBanditConfigs(b model.Bandit) error {
+	alg := algorithm.Get(b.BanditKey)
+	for _, arm := range b.Arms {
+		if _, err := alg.ArmStats(arm.Config); err != nil {
+			return fmt.Errorf("%w: variant %s: %s", snapshot.ErrInvalid, arm.VariantId, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	model "github.com/EbumbaE/bandit/services/bandit-indexer/internal"
+)
+
+// FormatVersion is bumped on incompatible changes of the file layout.
+const FormatVersion = 1
+
+var ErrInvalid = errors.New("invalid snapshot")
+
+// Snapshot is a portable copy of learned bandit state: bandit and arm configs,
+// counts and versions as stored by the indexer.
+type Snapshot struct {
+	FormatVersion int       `json:"format_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	Bandits       []Bandit  `json:"bandits"`
+}
+
+type Bandit struct {
+	RuleID    string          `json:"rule_id"`
+	Version   uint64          `json:"version"`
+	BanditKey string          `json:"bandit_key"`
+	Config    json.RawMessage `json:"config"`
+	State     string          `json:"state"`
+	Arms      []Arm           `json:"arms"`
+}
+
+type Arm struct {
+	VariantID string          `json:"variant_id"`
+	Count     uint64          `json:"count"`
+	Config    json.RawMessage `json:"config"`
+	State     string          `json:"state"`
+}
+
+func New(createdAt time.Time, bandits []model.Bandit) Snapshot {
+	s := Snapshot{
+		FormatVersion: FormatVersion,
+		CreatedAt:     createdAt.UTC(),
+		Bandits:       make([]Bandit, 0, len(bandits)),
+	}
+
+	for _, b := range bandits {
+		arms := make([]Arm, 0, len(b.Arms))
+		for _, a := range b.Arms {
+			arms = append(arms, Arm{
+				VariantID: a.VariantId,
+				Count:     a.Count,
+				Config:    a.Config,
+				State:     string(a.State),
+			})
+		}
+
+		s.Bandits = append(s.Bandits, Bandit{
+			RuleID:    b.RuleId,
+			Version:   b.Version,
+			BanditKey: b.BanditKey,
+			Config:    b.Config,
+			State:     string(b.State),
+			Arms:      arms,
+		})
+	}
+
+	return s
+}
+
+// Model converts the snapshot back to storage models.
+func (s Snapshot) Model() []model.Bandit {
+	res := make([]model.Bandit, 0, len(s.Bandits))
+
+	for _, b := range s.Bandits {
+		arms := make([]model.Arm, 0, len(b.Arms))
+		for _, a := range b.Arms {
+			arms = append(arms, model.Arm{
+				VariantId: a.VariantID,
+				Count:     a.Count,
+				Config:    a.Config,
+				State:     model.StateType(a.State),
+			})
+		}
+
+		res = append(res, model.Bandit{
+			RuleId:    b.RuleID,
+			Version:   b.Version,
+			BanditKey: b.BanditKey,
+			Config:    b.Config,
+			State:     model.StateType(b.State),
+			Arms:      arms,
+		})
+	}
+
+	return res
+}
+
+func (s Snapshot) Marshal() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
+
+func Unmarshal(data []byte) (Snapshot, error) {
+	var s Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return Snapshot{}, fmt.Errorf("%w: %s", ErrInvalid, err.Error())
+	}
+	if err := s.validate(); err != nil {
+		return Snapshot{}, err
+	}
+	return s, nil
+}
+
+func (s Snapshot) validate() error {
+	if s.FormatVersion != FormatVersion {
+		return fmt.Errorf("%w: format version %d, expected %d", ErrInvalid, s.FormatVersion, FormatVersion)
+	}
+
+	rules := make(map[string]struct{}, len(s.Bandits))
+	variants := map[string]struct{}{}
+	for _, b := range s.Bandits {
+		if len(b.RuleID) == 0 || len(b.BanditKey) == 0 {
+			return fmt.Errorf("%w: bandit without rule id or bandit key", ErrInvalid)
+		}
+		if _, ok := rules[b.RuleID]; ok {
+			return fmt.Errorf("%w: duplicate rule %s", ErrInvalid, b.RuleID)
+		}
+		rules[b.RuleID] = struct{}{}
+
+		if err := validateState(b.State); err != nil {
+			return fmt.Errorf("%w: rule %s: %s", ErrInvalid, b.RuleID, err.Error())
+		}
+
+		for _, a := range b.Arms {
+			if len(a.VariantID) == 0 {
+				return fmt.Errorf("%w: rule %s: arm without variant id", ErrInvalid, b.RuleID)
+			}
+			if _, ok := variants[a.VariantID]; ok {
+				return fmt.Errorf("%w: duplicate variant %s", ErrInvalid, a.VariantID)
+			}
+			variants[a.VariantID] = struct{}{}
+
+			if err := validateState(a.State); err != nil {
+				return fmt.Errorf("%w: variant %s: %s", ErrInvalid, a.VariantID, err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateState(state string) error {
+	switch model.StateType(state) {
+	case model.StateTypeEnable, model.StateTypeDisable:
+		return nil
+	default:
+		return fmt.Errorf("unknown state %q", state)
+	}
+}
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS bandit_info (
+    rule_id UUID NOT NULL PRIMARY KEY,
+    version BIGINT NOT NULL DEFAULT 0,
+
+    bandit_key TEXT NOT NULL,
+    config JSONB NOT NULL DEFAULT '{}',
+    state TEXT NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS arm_info (
+    variant_id UUID NOT NULL PRIMARY KEY,
+    rule_id TEXT NOT NULL,
+
+    count BIGINT NOT NULL DEFAULT 0,
+
+    config JSONB NOT NULL DEFAULT '{}',
+    state TEXT NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS arm_info_rule_id ON arm_info(rule_id);
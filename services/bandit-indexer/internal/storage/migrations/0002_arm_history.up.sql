@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS arm_history (
+    variant_id UUID NOT NULL,
+    rule_id TEXT NOT NULL,
+    bandit_version BIGINT NOT NULL,
+
+    count BIGINT NOT NULL,
+    mean DOUBLE PRECISION NOT NULL,
+    variance DOUBLE PRECISION NOT NULL,
+    config JSONB NOT NULL DEFAULT '{}',
+
+    recorded_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS arm_history_variant_id ON arm_history(variant_id, recorded_at);
+CREATE INDEX IF NOT EXISTS arm_history_rule_id ON arm_history(rule_id, recorded_at);
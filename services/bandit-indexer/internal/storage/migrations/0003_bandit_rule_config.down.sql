@@ -0,0 +1 @@
+ALTER TABLE bandit_info DROP COLUMN IF EXISTS rule_config;
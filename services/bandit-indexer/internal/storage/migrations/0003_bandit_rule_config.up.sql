@@ -0,0 +1 @@
+ALTER TABLE bandit_info ADD COLUMN IF NOT EXISTS rule_config JSONB;
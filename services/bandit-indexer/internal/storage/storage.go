@@ -2,7 +2,11 @@ package storage
 
 import (
 	"context"
+	"embed"
+	"slices"
+	"time"
 
+	"github.com/EbumbaE/bandit/pkg/migration"
 	"github.com/EbumbaE/bandit/pkg/psql"
 	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
@@ -12,53 +16,34 @@ import (
 
 var ErrNotFound = pgx.ErrNoRows
 
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
 type Storage struct {
 	conn psql.Database
 }
 
 func New(ctx context.Context, conn psql.Database) (*Storage, error) {
-	if err := initSchema(ctx, conn); err != nil {
-		return nil, errors.Wrap(err, "init schema")
+	m, err := NewMigrator(conn)
+	if err != nil {
+		return nil, errors.Wrap(err, "load migrations")
+	}
+	if _, err := m.Up(ctx); err != nil {
+		return nil, errors.Wrap(err, "migrate schema")
 	}
+
 	return &Storage{
 		conn: conn,
 	}, nil
 }
 
-func initSchema(ctx context.Context, db psql.Database) error {
-	query := `
-		CREATE TABLE IF NOT EXISTS bandit_info (
-			rule_id UUID NOT NULL PRIMARY KEY,
-			version BIGINT NOT NULL DEFAULT 0,
-			
-			bandit_key TEXT NOT NULL,
-			config JSONB NOT NULL DEFAULT '{}',
-			state TEXT NOT NULL,
-			
-			created_at TIMESTAMP NOT NULL DEFAULT now(),
-			updated_at TIMESTAMP NOT NULL DEFAULT now(),
-			deleted_at TIMESTAMP
-		);
-			
-		CREATE TABLE IF NOT EXISTS arm_info (
-			variant_id UUID NOT NULL PRIMARY KEY,
-			rule_id TEXT NOT NULL,
-
-			count BIGINT NOT NULL DEFAULT 0,
-			
-			config JSONB NOT NULL DEFAULT '{}',
-			state TEXT NOT NULL,
-			
-			created_at TIMESTAMP NOT NULL DEFAULT now(),
-			updated_at TIMESTAMP NOT NULL DEFAULT now(),
-			deleted_at TIMESTAMP
-		);
-		
-		CREATE INDEX IF NOT EXISTS arm_info_rule_id ON arm_info(rule_id);
-`
-
-	_, err := db.Exec(ctx, query)
-	return err
+// NewMigrator returns the migrator of the storage schema, New applies pending migrations.
+func NewMigrator(conn psql.Database) (*migration.Migrator, error) {
+	migrations, err := migration.Load(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	return psql.NewMigrator(conn, migrations), nil
 }
 
 func (s *Storage) GetBanditByRuleID(ctx context.Context, ruleID string) (model.Bandit, error) {
@@ -78,23 +63,97 @@ func (s *Storage) GetBanditByRuleID(ctx context.Context, ruleID string) (model.B
 	return r, err
 }
 
+// GetBanditForUpdate is GetBanditByRuleID locking the bandit row until the end
+// of the transaction. Writers of the rule arms take this lock first, so their
+// read-modify-write of arms does not lose updates.
+func (s *Storage) GetBanditForUpdate(ctx context.Context, ruleID string) (model.Bandit, error) {
+	var r model.Bandit
+
+	query := `
+		SELECT rule_id, version, bandit_key, config, state
+		FROM bandit_info
+		WHERE rule_id = $1 AND deleted_at is NULL AND state = $2
+		FOR UPDATE;
+		`
+
+	err := s.conn.GetSingle(ctx, &r, query, ruleID, model.StateTypeEnable)
+	if len(r.RuleId) == 0 || errors.Is(err, pgx.ErrNoRows) {
+		return model.Bandit{}, ErrNotFound
+	}
+
+	return r, err
+}
+
+// GetBandit returns the bandit in any state, unlike GetBanditByRuleID used for serving.
+func (s *Storage) GetBandit(ctx context.Context, ruleID string) (model.Bandit, error) {
+	var r model.Bandit
+
+	query := `
+		SELECT rule_id, version, bandit_key, config, state, rule_config
+		FROM bandit_info
+		WHERE rule_id = $1 AND deleted_at is NULL;
+		`
+
+	err := s.conn.GetSingle(ctx, &r, query, ruleID)
+	if len(r.RuleId) == 0 || errors.Is(err, pgx.ErrNoRows) {
+		return model.Bandit{}, ErrNotFound
+	}
+
+	return r, err
+}
+
+// MigrateBandit stores the bandit key, config and arms of a migrated bandit
+// and bumps its version.
+func (s *Storage) MigrateBandit(ctx context.Context, bandit model.Bandit) error {
+	banditQuery := `
+		UPDATE bandit_info 
+		SET 
+			bandit_key = $2,
+			config = $3,
+			rule_config = $4,
+			version = version + 1,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE rule_id = $1 AND deleted_at IS NULL;
+`
+
+	armQuery := `
+		UPDATE arm_info 
+		SET 
+			config = $2, count = $3,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE variant_id = $1;
+`
+
+	return s.conn.WrapWithTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, banditQuery, bandit.RuleId, bandit.BanditKey, bandit.Config, bandit.RuleConfig); err != nil {
+			return errors.Wrap(err, "migrate bandit")
+		}
+		for _, arm := range bandit.Arms {
+			if _, err := tx.Exec(ctx, armQuery, arm.VariantId, arm.Config, arm.Count); err != nil {
+				return errors.Wrapf(err, "migrate arm %s", arm.VariantId)
+			}
+		}
+		return nil
+	})
+}
+
 func (s *Storage) CreateBandit(ctx context.Context, bandit model.Bandit) (model.Bandit, error) {
 	query := `
 		INSERT INTO bandit_info
 		(
 			created_at, updated_at,
-			rule_id, bandit_key, config, state
+			rule_id, bandit_key, config, state, rule_config
 		)
 		VALUES
 		(
 			NOW() at time zone 'utc', NOW() at time zone 'utc',
-			$1, $2, $3, $4
+			$1, $2, $3, $4, $5
 		)
 		RETURNING rule_id;
 `
 
 	var ruleID string
-	err := s.conn.QueryRow(ctx, query, bandit.RuleId, bandit.BanditKey, bandit.Config, bandit.State).Scan(&ruleID)
+	err := s.conn.QueryRow(ctx, query, bandit.RuleId, bandit.BanditKey, bandit.Config, bandit.State, bandit.RuleConfig).Scan(&ruleID)
 	if err != nil {
 		return model.Bandit{}, err
 	}
@@ -120,6 +179,20 @@ func (s *Storage) SetBanditState(ctx context.Context, ruleID string, state model
 	return err
 }
 
+func (s *Storage) SetBanditRuleConfig(ctx context.Context, ruleID string, config []byte) error {
+	query := `
+		UPDATE bandit_info 
+		SET 
+			rule_config = $2,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE rule_id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, ruleID, config)
+
+	return err
+}
+
 func (s *Storage) UpBanditVersion(ctx context.Context, ruleID string) error {
 	query := `
 		UPDATE bandit_info 
@@ -135,7 +208,7 @@ func (s *Storage) UpBanditVersion(ctx context.Context, ruleID string) error {
 }
 
 func (s *Storage) DeleteBandit(ctx context.Context, ruleID string) error {
-	query := `
+	banditQuery := `
 		UPDATE bandit_info 
 		SET 
 			deleted_at = NOW() at time zone 'utc',
@@ -143,9 +216,23 @@ func (s *Storage) DeleteBandit(ctx context.Context, ruleID string) error {
 		WHERE rule_id = $1;
 `
 
-	_, err := s.conn.Exec(ctx, query, ruleID)
+	armQuery := `
+		UPDATE arm_info 
+		SET 
+			deleted_at = NOW() at time zone 'utc',
+			updated_at = NOW() at time zone 'utc' 
+		WHERE rule_id = $1 AND deleted_at IS NULL;
+`
 
-	return err
+	return s.conn.WrapWithTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, banditQuery, ruleID); err != nil {
+			return errors.Wrap(err, "delete bandit")
+		}
+		if _, err := tx.Exec(ctx, armQuery, ruleID); err != nil {
+			return errors.Wrap(err, "delete arms")
+		}
+		return nil
+	})
 }
 
 func (s *Storage) GetArms(ctx context.Context, ruleID string) ([]model.Arm, error) {
@@ -165,6 +252,21 @@ func (s *Storage) GetArms(ctx context.Context, ruleID string) ([]model.Arm, erro
 	return v, err
 }
 
+// GetAllArms returns arms of the rule in any state.
+func (s *Storage) GetAllArms(ctx context.Context, ruleID string) ([]model.Arm, error) {
+	var v []model.Arm
+
+	query := `
+		SELECT variant_id, count, config, state
+		FROM arm_info
+		WHERE rule_id = $1 AND deleted_at is NULL;
+`
+
+	err := s.conn.GetSlice(ctx, &v, query, ruleID)
+
+	return v, err
+}
+
 func (s *Storage) GetArm(ctx context.Context, variantID string) (model.Arm, error) {
 	var v model.Arm
 
@@ -187,18 +289,39 @@ func (s *Storage) AddArm(ctx context.Context, ruleID string, v model.Arm) (model
 		INSERT INTO arm_info
 		(
 			created_at, updated_at,
-			rule_id, variant_id, config, state
+			rule_id, variant_id, config, state, count
 		)
 		VALUES
 		(
 			NOW() at time zone 'utc', NOW() at time zone 'utc',
-			$1, $2, $3, $4
+			$1, $2, $3, $4, $5
 		)
+		ON CONFLICT (variant_id) DO UPDATE
+		SET
+			state = EXCLUDED.state,
+			updated_at = NOW() at time zone 'utc',
+			deleted_at = NULL
+		WHERE arm_info.deleted_at IS NOT NULL
 		RETURNING variant_id;
 `
 
+	existingQuery := `
+		SELECT variant_id, count, config, state
+		FROM arm_info
+		WHERE variant_id = $1;
+`
+
+	// a variant restored by rule rollback revives its deleted arm with statistics,
+	// a redelivered create event finds the live arm and keeps it
 	var variantID string
-	err := s.conn.QueryRow(ctx, query, ruleID, v.VariantId, v.Config, v.State).Scan(&variantID)
+	err := s.conn.QueryRow(ctx, query, ruleID, v.VariantId, v.Config, v.State, v.Count).Scan(&variantID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		var existing model.Arm
+		if err := s.conn.GetSingle(ctx, &existing, existingQuery, v.VariantId); err != nil {
+			return model.Arm{}, errors.Wrap(err, "get existing arm")
+		}
+		return existing, nil
+	}
 	if err != nil {
 		return model.Arm{}, err
 	}
@@ -251,3 +374,221 @@ func (s *Storage) DeleteArm(ctx context.Context, variantID string) error {
 
 	return err
 }
+
+// ExportBandits reads a page of at most limit bandits with rule id after the
+// given one, with all their arms in one repeatable read transaction, so the
+// page is a consistent point-in-time state. Empty ruleIDs exports every bandit.
+func (s *Storage) ExportBandits(ctx context.Context, ruleIDs []string, after string, limit uint64) ([]model.Bandit, error) {
+	banditQuery := `
+		SELECT rule_id, version, bandit_key, config, state
+		FROM bandit_info
+		WHERE deleted_at is NULL AND (cardinality($1::text[]) = 0 OR rule_id::text = ANY($1::text[]))
+			AND rule_id::text > $2
+		ORDER BY rule_id::text
+		LIMIT $3;
+`
+
+	armQuery := `
+		SELECT rule_id, variant_id, count, config, state
+		FROM arm_info
+		WHERE deleted_at is NULL AND rule_id = ANY($1::text[])
+		ORDER BY rule_id, variant_id;
+`
+
+	if ruleIDs == nil {
+		ruleIDs = []string{}
+	}
+
+	var bandits []model.Bandit
+	err := s.conn.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := s.conn.Exec(ctx, `SET TRANSACTION ISOLATION LEVEL REPEATABLE READ READ ONLY;`); err != nil {
+			return errors.Wrap(err, "set isolation level")
+		}
+
+		if err := s.conn.GetSlice(ctx, &bandits, banditQuery, ruleIDs, after, limit); err != nil {
+			return errors.Wrap(err, "get bandits")
+		}
+
+		pageIDs := make([]string, 0, len(bandits))
+		for _, b := range bandits {
+			pageIDs = append(pageIDs, b.RuleId)
+		}
+
+		var arms []struct {
+			RuleId string `db:"rule_id"`
+			model.Arm
+		}
+		if err := s.conn.GetSlice(ctx, &arms, armQuery, pageIDs); err != nil {
+			return errors.Wrap(err, "get arms")
+		}
+
+		byRule := make(map[string][]model.Arm, len(bandits))
+		for _, a := range arms {
+			byRule[a.RuleId] = append(byRule[a.RuleId], a.Arm)
+		}
+		for i := range bandits {
+			bandits[i].Arms = byRule[bandits[i].RuleId]
+		}
+
+		return nil
+	})
+
+	return bandits, err
+}
+
+// RestoreBandits writes learned state of the snapshot over live bandits with
+// the same bandit key: the bandit config, arm counts and configs. State and
+// deleted bandits and arms are owned by rule-admin and left as is. The version
+// never goes back, so consumers caching by version pick up restored state.
+// Returns restored bandits with their new version and restored arms.
+func (s *Storage) RestoreBandits(ctx context.Context, bandits []model.Bandit) ([]model.Bandit, error) {
+	banditQuery := `
+		UPDATE bandit_info
+		SET
+			version = GREATEST(version + 1, $2),
+			config = $4,
+			updated_at = NOW() at time zone 'utc'
+		WHERE rule_id = $1 AND bandit_key = $3 AND deleted_at IS NULL
+		RETURNING version;
+`
+
+	armQuery := `
+		UPDATE arm_info
+		SET
+			count = $3,
+			config = $4,
+			updated_at = NOW() at time zone 'utc'
+		WHERE variant_id = $1 AND rule_id = $2 AND deleted_at IS NULL;
+`
+
+	var restored []model.Bandit
+	err := s.conn.WrapWithTx(ctx, func(tx pgx.Tx) error {
+		for _, b := range bandits {
+			var version uint64
+			err := tx.QueryRow(ctx, banditQuery, b.RuleId, b.Version, b.BanditKey, b.Config).Scan(&version)
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return errors.Wrapf(err, "restore bandit %s", b.RuleId)
+			}
+
+			arms := make([]model.Arm, 0, len(b.Arms))
+			for _, arm := range b.Arms {
+				tag, err := tx.Exec(ctx, armQuery, arm.VariantId, b.RuleId, arm.Count, arm.Config)
+				if err != nil {
+					return errors.Wrapf(err, "restore arm %s", arm.VariantId)
+				}
+				if tag.RowsAffected() > 0 {
+					arms = append(arms, arm)
+				}
+			}
+
+			b.Version, b.Arms = version, arms
+			restored = append(restored, b)
+		}
+		return nil
+	})
+
+	return restored, err
+}
+
+// AddArmHistory appends the arm state unless the arm already has a point
+// recorded within interval.
+func (s *Storage) AddArmHistory(ctx context.Context, point model.ArmHistoryPoint, interval time.Duration) error {
+	query := `
+		INSERT INTO arm_history
+		(
+			variant_id, rule_id, bandit_version,
+			count, mean, variance, config,
+			recorded_at
+		)
+		SELECT $1, $2, $3, $4, $5, $6, $7, NOW() at time zone 'utc'
+		WHERE NOT EXISTS (
+			SELECT 1 FROM arm_history
+			WHERE variant_id = $1 AND recorded_at > NOW() at time zone 'utc' - make_interval(secs => $8)
+		);
+`
+
+	_, err := s.conn.Exec(ctx, query,
+		point.VariantId, point.RuleId, point.BanditVersion,
+		point.Count, point.Mean, point.Variance, point.Config,
+		interval.Seconds(),
+	)
+
+	return err
+}
+
+// GetArmHistory returns the newest limit recorded points of the rule arms
+// ordered by time, of one arm for non-empty variantID.
+func (s *Storage) GetArmHistory(ctx context.Context, ruleID, variantID string, from, to time.Time, limit uint64) ([]model.ArmHistoryPoint, error) {
+	var v []model.ArmHistoryPoint
+
+	query := `
+		SELECT variant_id, rule_id, bandit_version, count, mean, variance, config, recorded_at
+		FROM arm_history
+		WHERE rule_id = $1 AND ($2 = '' OR variant_id::text = $2)
+			AND recorded_at >= $3 AND recorded_at < $4
+		ORDER BY recorded_at DESC, variant_id DESC
+		LIMIT $5;
+`
+
+	if err := s.conn.GetSlice(ctx, &v, query, ruleID, variantID, from, to, limit); err != nil {
+		return nil, err
+	}
+	slices.Reverse(v)
+
+	return v, nil
+}
+
+// ResetArms replaces config and count of the rule arms, all arms for empty
+// variantIDs, and bumps the bandit version. The bandit row is locked before
+// arms, in the order of the other arm writers. Returns ids of reset arms.
+func (s *Storage) ResetArms(ctx context.Context, ruleID string, variantIDs []string, config []byte) ([]string, error) {
+	armQuery := `
+		UPDATE arm_info 
+		SET 
+			config = $3, count = 0,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE rule_id = $1 AND deleted_at IS NULL
+			AND (cardinality($2::text[]) = 0 OR variant_id::text = ANY($2::text[]))
+		RETURNING variant_id;
+`
+
+	banditQuery := `
+		UPDATE bandit_info 
+		SET 
+			version = version + 1,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE rule_id = $1;
+`
+
+	if variantIDs == nil {
+		variantIDs = []string{}
+	}
+
+	var reset []string
+	err := s.conn.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := s.conn.Exec(ctx, banditQuery, ruleID); err != nil {
+			return errors.Wrap(err, "up bandit version")
+		}
+
+		if err := s.conn.GetSlice(ctx, &reset, armQuery, ruleID, variantIDs, config); err != nil {
+			return errors.Wrap(err, "reset arms")
+		}
+		if len(reset) == 0 {
+			return ErrNotFound
+		}
+
+		return nil
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, ErrNotFound
+	}
+
+	return reset, err
+}
+
+func (s *Storage) RunInTx(ctx context.Context, exec func(ctx context.Context) error) error {
+	return s.conn.RunInTx(ctx, exec)
+}
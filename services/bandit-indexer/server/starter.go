@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/EbumbaE/bandit/pkg/auth"
 	"github.com/EbumbaE/bandit/pkg/logger"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	httpSwagger "github.com/swaggo/http-swagger"
@@ -34,7 +35,7 @@ func InitBanditIndexerSwagger(ctx context.Context, wg *sync.WaitGroup, swaggerPa
 		httpSwagger.URL("http://"+swaggerHost+"/swagger.json"),
 	))
 
-	grpcMux := runtime.NewServeMux()
+	grpcMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(auth.GatewayHeaderMatcher))
 	if err := banditindexer.RegisterBanditIndexerServiceHandlerFromEndpoint(ctx, grpcMux, grpcHost, []grpc.DialOption{grpc.WithInsecure()}); err != nil {
 		logger.Error("failed to register gateway handler", zap.Error(err))
 	}
@@ -68,12 +69,12 @@ func InitBanditIndexerSwagger(ctx context.Context, wg *sync.WaitGroup, swaggerPa
 	}()
 }
 
-func StarBanditIndexer(ctx context.Context, serv banditindexer.BanditIndexerServiceServer, wg *sync.WaitGroup, host string) {
+func StarBanditIndexer(ctx context.Context, serv banditindexer.BanditIndexerServiceServer, wg *sync.WaitGroup, host string, opts ...grpc.ServerOption) {
 	listener, err := net.Listen("tcp", host)
 	if err != nil {
 		logger.Error("failed to listen in sender server", zap.Error(err))
 	}
-	server := grpc.NewServer()
+	server := grpc.NewServer(opts...)
 	banditindexer.RegisterBanditIndexerServiceServer(server, serv)
 	reflection.Register(server)
 
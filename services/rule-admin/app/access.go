@@ -0,0 +1,71 @@
+package app
+
+import (
+	"github.com/EbumbaE/bandit/pkg/auth"
+	desc "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
+)
+
+var MethodRoles = map[string]auth.Role{
+	desc.RuleAdminService_GetRule_FullMethodName:               auth.RoleViewer,
+	desc.RuleAdminService_ListRules_FullMethodName:             auth.RoleViewer,
+	desc.RuleAdminService_CheckRule_FullMethodName:             auth.RoleViewer,
+	desc.RuleAdminService_GetRuleServiceContext_FullMethodName: auth.RoleViewer,
+	desc.RuleAdminService_ListRuleRevisions_FullMethodName:     auth.RoleViewer,
+	desc.RuleAdminService_GetRuleRevision_FullMethodName:       auth.RoleViewer,
+	desc.RuleAdminService_DiffRuleRevisions_FullMethodName:     auth.RoleViewer,
+	desc.RuleAdminService_GetLayer_FullMethodName:              auth.RoleViewer,
+	desc.RuleAdminService_GetVariant_FullMethodName:            auth.RoleViewer,
+	desc.RuleAdminService_CheckVariant_FullMethodName:          auth.RoleViewer,
+	desc.RuleAdminService_GetVariantData_FullMethodName:        auth.RoleViewer,
+	desc.RuleAdminService_GetRuleGuardrails_FullMethodName:     auth.RoleViewer,
+	desc.RuleAdminService_ListGuardrails_FullMethodName:        auth.RoleViewer,
+	desc.RuleAdminService_GetWantedRegistry_FullMethodName:     auth.RoleViewer,
+	desc.RuleAdminService_GetWantedBandit_FullMethodName:       auth.RoleViewer,
+	desc.RuleAdminService_ListStaleBanditRules_FullMethodName:  auth.RoleViewer,
+	desc.RuleAdminService_GetTenant_FullMethodName:             auth.RoleViewer,
+	desc.RuleAdminService_ListTenants_FullMethodName:           auth.RoleViewer,
+	desc.RuleAdminService_ListChangeRequests_FullMethodName:    auth.RoleViewer,
+	desc.RuleAdminService_GetChangeRequest_FullMethodName:      auth.RoleViewer,
+	desc.RuleAdminService_GetRuleTemplate_FullMethodName:       auth.RoleViewer,
+	desc.RuleAdminService_ListRuleTemplates_FullMethodName:     auth.RoleViewer,
+	desc.RuleAdminService_ExportRules_FullMethodName:           auth.RoleViewer,
+
+	desc.RuleAdminService_CreateRule_FullMethodName:              auth.RoleEditor,
+	desc.RuleAdminService_UpdateRule_FullMethodName:              auth.RoleEditor,
+	desc.RuleAdminService_SetRuleState_FullMethodName:            auth.RoleEditor,
+	desc.RuleAdminService_SetRuleSchedule_FullMethodName:         auth.RoleEditor,
+	desc.RuleAdminService_SetRuleVariantSchema_FullMethodName:    auth.RoleEditor,
+	desc.RuleAdminService_SetRuleBanditConfig_FullMethodName:     auth.RoleEditor,
+	desc.RuleAdminService_SetRuleTargeting_FullMethodName:        auth.RoleEditor,
+	desc.RuleAdminService_SetRuleLayer_FullMethodName:            auth.RoleEditor,
+	desc.RuleAdminService_MigrateRuleBandit_FullMethodName:       auth.RoleEditor,
+	desc.RuleAdminService_ResetStatistics_FullMethodName:         auth.RoleEditor,
+	desc.RuleAdminService_RollbackRule_FullMethodName:            auth.RoleEditor,
+	desc.RuleAdminService_CreateLayer_FullMethodName:             auth.RoleEditor,
+	desc.RuleAdminService_AddVariant_FullMethodName:              auth.RoleEditor,
+	desc.RuleAdminService_UpdateVariant_FullMethodName:           auth.RoleEditor,
+	desc.RuleAdminService_DeleteVariant_FullMethodName:           auth.RoleEditor,
+	desc.RuleAdminService_SetVariantState_FullMethodName:         auth.RoleEditor,
+	desc.RuleAdminService_SetVariantSchedule_FullMethodName:      auth.RoleEditor,
+	desc.RuleAdminService_SubmitChangeRequest_FullMethodName:     auth.RoleEditor,
+	desc.RuleAdminService_ApproveChangeRequest_FullMethodName:    auth.RoleEditor,
+	desc.RuleAdminService_RejectChangeRequest_FullMethodName:     auth.RoleEditor,
+	desc.RuleAdminService_ApplyChangeRequest_FullMethodName:      auth.RoleEditor,
+	desc.RuleAdminService_CloneRule_FullMethodName:               auth.RoleEditor,
+	desc.RuleAdminService_CreateRuleTemplate_FullMethodName:      auth.RoleEditor,
+	desc.RuleAdminService_DeleteRuleTemplate_FullMethodName:      auth.RoleEditor,
+	desc.RuleAdminService_InstantiateRuleTemplate_FullMethodName: auth.RoleEditor,
+	desc.RuleAdminService_ImportRules_FullMethodName:             auth.RoleEditor,
+
+	desc.RuleAdminService_DeleteRule_FullMethodName:            auth.RoleAdmin,
+	desc.RuleAdminService_AddGuardrail_FullMethodName:          auth.RoleAdmin,
+	desc.RuleAdminService_RemoveGuardrail_FullMethodName:       auth.RoleAdmin,
+	desc.RuleAdminService_CreateWantedBandit_FullMethodName:    auth.RoleAdmin,
+	desc.RuleAdminService_UpdateWantedBandit_FullMethodName:    auth.RoleAdmin,
+	desc.RuleAdminService_DeprecateWantedBandit_FullMethodName: auth.RoleAdmin,
+	desc.RuleAdminService_ListAuditEvents_FullMethodName:       auth.RoleAdmin,
+	desc.RuleAdminService_CreateTenant_FullMethodName:          auth.RoleAdmin,
+	desc.RuleAdminService_UpdateTenant_FullMethodName:          auth.RoleAdmin,
+	desc.RuleAdminService_SetTenantServices_FullMethodName:     auth.RoleAdmin,
+	desc.RuleAdminService_SetRuleProtected_FullMethodName:      auth.RoleAdmin,
+}
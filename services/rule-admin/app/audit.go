@@ -0,0 +1,164 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/EbumbaE/bandit/pkg/auth"
+	desc "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
+	"github.com/EbumbaE/bandit/pkg/logger"
+	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+)
+
+var auditedMethods = map[string]struct{}{
+	desc.RuleAdminService_CreateRule_FullMethodName:              {},
+	desc.RuleAdminService_UpdateRule_FullMethodName:              {},
+	desc.RuleAdminService_SetRuleState_FullMethodName:            {},
+	desc.RuleAdminService_DeleteRule_FullMethodName:              {},
+	desc.RuleAdminService_SetRuleSchedule_FullMethodName:         {},
+	desc.RuleAdminService_SetRuleVariantSchema_FullMethodName:    {},
+	desc.RuleAdminService_SetRuleBanditConfig_FullMethodName:     {},
+	desc.RuleAdminService_SetRuleTargeting_FullMethodName:        {},
+	desc.RuleAdminService_SetRuleLayer_FullMethodName:            {},
+	desc.RuleAdminService_RollbackRule_FullMethodName:            {},
+	desc.RuleAdminService_CreateLayer_FullMethodName:             {},
+	desc.RuleAdminService_AddVariant_FullMethodName:              {},
+	desc.RuleAdminService_UpdateVariant_FullMethodName:           {},
+	desc.RuleAdminService_DeleteVariant_FullMethodName:           {},
+	desc.RuleAdminService_SetVariantState_FullMethodName:         {},
+	desc.RuleAdminService_SetVariantSchedule_FullMethodName:      {},
+	desc.RuleAdminService_AddGuardrail_FullMethodName:            {},
+	desc.RuleAdminService_RemoveGuardrail_FullMethodName:         {},
+	desc.RuleAdminService_CreateWantedBandit_FullMethodName:      {},
+	desc.RuleAdminService_UpdateWantedBandit_FullMethodName:      {},
+	desc.RuleAdminService_DeprecateWantedBandit_FullMethodName:   {},
+	desc.RuleAdminService_CreateTenant_FullMethodName:            {},
+	desc.RuleAdminService_UpdateTenant_FullMethodName:            {},
+	desc.RuleAdminService_SetTenantServices_FullMethodName:       {},
+	desc.RuleAdminService_SetRuleProtected_FullMethodName:        {},
+	desc.RuleAdminService_MigrateRuleBandit_FullMethodName:       {},
+	desc.RuleAdminService_ResetStatistics_FullMethodName:         {},
+	desc.RuleAdminService_SubmitChangeRequest_FullMethodName:     {},
+	desc.RuleAdminService_ApproveChangeRequest_FullMethodName:    {},
+	desc.RuleAdminService_RejectChangeRequest_FullMethodName:     {},
+	desc.RuleAdminService_ApplyChangeRequest_FullMethodName:      {},
+	desc.RuleAdminService_CloneRule_FullMethodName:               {},
+	desc.RuleAdminService_CreateRuleTemplate_FullMethodName:      {},
+	desc.RuleAdminService_DeleteRuleTemplate_FullMethodName:      {},
+	desc.RuleAdminService_InstantiateRuleTemplate_FullMethodName: {},
+	desc.RuleAdminService_ImportRules_FullMethodName:             {},
+}
+
+func (i *Implementation) AuditInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := auditedMethods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		ruleID := auditRuleID(req, nil)
+		prevState := i.ruleSnapshot(ctx, ruleID)
+
+		resp, err := handler(ctx, req)
+
+		if len(ruleID) == 0 {
+			ruleID = auditRuleID(req, resp)
+		}
+
+		event := model.AuditEvent{
+			Actor:     auth.ActorFromContext(ctx),
+			Method:    info.FullMethod,
+			RuleId:    ruleID,
+			PrevState: prevState,
+			NewState:  i.ruleSnapshot(ctx, ruleID),
+		}
+		if msg, ok := req.(proto.Message); ok {
+			if body, mErr := protojson.Marshal(msg); mErr == nil {
+				event.Request = string(body)
+			}
+		}
+		if err != nil {
+			event.Error = err.Error()
+		}
+
+		if aErr := i.ruleProvider.AddAuditEvent(context.WithoutCancel(ctx), event); aErr != nil {
+			logger.Error("failed save audit event", zap.String("method", info.FullMethod), zap.Error(aErr))
+		}
+
+		return resp, err
+	}
+}
+
+func auditRuleID(req, resp any) string {
+	switch r := req.(type) {
+	case *desc.CreateRuleRequest, *desc.CloneRuleRequest, *desc.InstantiateRuleTemplateRequest:
+		if res, ok := resp.(*desc.RuleResponse); ok {
+			return res.GetRule().GetId()
+		}
+	case *desc.GetRuleRequest:
+		return r.GetId()
+	case *desc.ModifyRuleRequest:
+		return r.GetId()
+	case *desc.SetRuleStateRequest:
+		return r.GetId()
+	case *desc.SetRuleScheduleRequest:
+		return r.GetId()
+	case *desc.SetRuleVariantSchemaRequest:
+		return r.GetId()
+	case *desc.SetRuleBanditConfigRequest:
+		return r.GetId()
+	case *desc.SetRuleTargetingRequest:
+		return r.GetId()
+	case *desc.SetRuleLayerRequest:
+		return r.GetId()
+	case *desc.RollbackRuleRequest:
+		return r.GetRuleId()
+	case *desc.AddVariantRequest:
+		return r.GetRuleId()
+	case *desc.UpdateVariantRequest:
+		return r.GetRuleId()
+	case *desc.RemoveVariantRequest:
+		return r.GetRuleId()
+	case *desc.SetVariantStateRequest:
+		return r.GetRuleId()
+	case *desc.SetVariantScheduleRequest:
+		return r.GetRuleId()
+	case *desc.AddGuardrailRequest:
+		return r.GetGuardrail().GetRuleId()
+	case *desc.SetRuleProtectedRequest:
+		return r.GetId()
+	case *desc.MigrateRuleBanditRequest:
+		return r.GetId()
+	case *desc.ResetStatisticsRequest:
+		return r.GetRuleId()
+	case *desc.GetChangeRequestRequest, *desc.ReviewChangeRequestRequest:
+		if res, ok := resp.(*desc.ChangeRequestResponse); ok {
+			return res.GetChangeRequest().GetRuleId()
+		}
+	}
+
+	return ""
+}
+
+func (i *Implementation) ruleSnapshot(ctx context.Context, ruleID string) string {
+	if len(ruleID) == 0 {
+		return ""
+	}
+
+	r, err := i.ruleProvider.GetRule(ctx, ruleID)
+	if err != nil {
+		return ""
+	}
+
+	snapshot, err := json.Marshal(r)
+	if err != nil {
+		logger.Error("marshal rule snapshot", zap.String("rule_id", ruleID), zap.Error(err))
+		return ""
+	}
+
+	return string(snapshot)
+}
@@ -2,31 +2,93 @@ package app
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	desc "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
 	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/bundle"
 	"github.com/EbumbaE/bandit/services/rule-admin/internal/provider"
 )
 
 type AdminProvider interface {
 	GetRule(ctx context.Context, id string) (model.Rule, error)
+	ListRules(ctx context.Context, filter model.RuleFilter) ([]model.Rule, *model.RuleCursor, error)
 	CreateRule(ctx context.Context, rule model.Rule) (model.Rule, error)
 	UpdateRule(ctx context.Context, rule model.Rule) (model.Rule, error)
 	SetRuleState(ctx context.Context, id string, state model.StateType) error
-	GetRuleServiceContext(ctx context.Context, ruleID string) (string, string, error)
+	DeleteRule(ctx context.Context, id string) error
+	GetRuleServiceContext(ctx context.Context, ruleID string) (model.Rule, error)
+	SetRuleLayer(ctx context.Context, id, layerID string) error
+	SetRuleSchedule(ctx context.Context, id string, startAt, endAt *time.Time) error
+	SetRuleTargeting(ctx context.Context, id string, priority int32, targeting []model.TargetingCondition) error
+	SetRuleVariantSchema(ctx context.Context, id, variantSchema string) error
+	SetRuleBanditConfig(ctx context.Context, id, banditConfig string) error
+
+	ListRuleRevisions(ctx context.Context, ruleID string) ([]model.RuleRevision, error)
+	GetRuleRevision(ctx context.Context, ruleID string, revision uint64) (model.RuleRevision, error)
+	DiffRuleRevisions(ctx context.Context, ruleID string, fromRevision, toRevision uint64) ([]model.RevisionChange, error)
+	RollbackRule(ctx context.Context, ruleID string, revision uint64) (model.Rule, error)
 
 	GetVariant(ctx context.Context, ruleID, variandID string) (model.Variant, error)
 	AddVariant(ctx context.Context, ruleID string, v model.Variant) (model.Variant, error)
 	SetVariantState(ctx context.Context, ruleID, variandID string, state model.StateType) error
+	SetVariantSchedule(ctx context.Context, ruleID, variantID string, startAt, endAt *time.Time) error
+	UpdateVariant(ctx context.Context, ruleID string, v model.Variant) (model.Variant, error)
+	DeleteVariant(ctx context.Context, ruleID, variantID string) error
+
+	CreateLayer(ctx context.Context, layer model.Layer) (model.Layer, error)
+	GetLayer(ctx context.Context, id string) (model.Layer, error)
+
+	AddGuardrail(ctx context.Context, g model.Guardrail) (model.Guardrail, error)
+	RemoveGuardrail(ctx context.Context, id string) error
+	GetRuleGuardrails(ctx context.Context, ruleID string) ([]model.Guardrail, error)
+	ListGuardrails(ctx context.Context) ([]model.Guardrail, error)
 
 	CreateWantedBandit(ctx context.Context, wb model.WantedBandit) error
 	GetWantedRegistry(ctx context.Context) ([]model.WantedBandit, error)
+	GetWantedBandit(ctx context.Context, banditKey string) (model.WantedBandit, error)
+	UpdateWantedBandit(ctx context.Context, wb model.WantedBandit) (model.WantedBandit, error)
+	DeprecateWantedBandit(ctx context.Context, banditKey string, deprecated bool, reason string) (model.WantedBandit, []model.Rule, error)
+	ListStaleBanditRules(ctx context.Context) ([]model.StaleBanditRule, error)
+
+	AddAuditEvent(ctx context.Context, e model.AuditEvent) error
+	ListAuditEvents(ctx context.Context, filter model.AuditFilter) ([]model.AuditEvent, error)
+
+	CreateTenant(ctx context.Context, t model.Tenant) (model.Tenant, error)
+	UpdateTenant(ctx context.Context, t model.Tenant) (model.Tenant, error)
+	SetTenantServices(ctx context.Context, name string, services []string) (model.Tenant, error)
+	GetTenant(ctx context.Context, name string) (model.Tenant, error)
+	ListTenants(ctx context.Context) ([]model.Tenant, error)
+
+	SetRuleProtected(ctx context.Context, id string, protected bool) error
+	MigrateRuleBandit(ctx context.Context, id, banditKey string) (model.Rule, error)
+	ResetStatistics(ctx context.Context, ruleID, variantID string) error
+	GetChangeRequest(ctx context.Context, id string) (model.ChangeRequest, error)
+	ListChangeRequests(ctx context.Context, filter model.ChangeRequestFilter) ([]model.ChangeRequest, error)
+	SubmitChangeRequest(ctx context.Context, id string) (model.ChangeRequest, error)
+	ApproveChangeRequest(ctx context.Context, id, comment string) (model.ChangeRequest, error)
+	RejectChangeRequest(ctx context.Context, id, comment string) (model.ChangeRequest, error)
+	ApplyChangeRequest(ctx context.Context, id string) (model.ChangeRequest, error)
+
+	CloneRule(ctx context.Context, ruleID string, target model.CloneTarget) (model.Rule, error)
+	CreateRuleTemplate(ctx context.Context, t model.RuleTemplate) (model.RuleTemplate, error)
+	GetRuleTemplate(ctx context.Context, name string) (model.RuleTemplate, error)
+	ListRuleTemplates(ctx context.Context) ([]model.RuleTemplate, error)
+	DeleteRuleTemplate(ctx context.Context, name string) error
+	InstantiateRuleTemplate(ctx context.Context, name string, inst model.TemplateInstance) (model.Rule, error)
+
+	ExportRules(ctx context.Context, service, context string) ([]model.Rule, error)
+	ImportRules(ctx context.Context, rules []model.Rule, opts model.ImportOptions) ([]model.ImportChange, error)
 }
 
 type Implementation struct {
@@ -60,6 +122,45 @@ func (i *Implementation) GetRule(ctx context.Context, req *desc.GetRuleRequest)
 	return decodeRuleResponse(r), nil
 }
 
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+func (i *Implementation) ListRules(ctx context.Context, req *desc.ListRulesRequest) (*desc.ListRulesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ListRules")
+	defer span.Finish()
+
+	filter, err := encodeRuleFilter(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rules, cursor, err := i.ruleProvider.ListRules(ctx, filter)
+	if err != nil {
+		if errors.Is(err, provider.ErrForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &desc.ListRulesResponse{
+		Rules: make([]*desc.Rule, len(rules)),
+	}
+	for i, r := range rules {
+		resp.Rules[i] = decodeRule(r)
+	}
+
+	if cursor != nil {
+		resp.NextPageToken, err = encodePageToken(*cursor)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
+
 func (i *Implementation) CreateRule(ctx context.Context, req *desc.CreateRuleRequest) (*desc.RuleResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "api/CreateRule")
 	defer span.Finish()
@@ -68,8 +169,36 @@ func (i *Implementation) CreateRule(ctx context.Context, req *desc.CreateRuleReq
 		return nil, status.Error(codes.InvalidArgument, "Invalid name, bandit_key, context or serivce")
 	}
 
+	if err := validateSchedule(encodeTimestamp(req.GetStartAt()), encodeTimestamp(req.GetEndAt())); err != nil {
+		return nil, err
+	}
+
+	if err := validateTargeting(req.GetTargeting()); err != nil {
+		return nil, err
+	}
+
 	r, err := i.ruleProvider.CreateRule(ctx, encodeCreateRule(req))
 	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "layer not found")
+		}
+		if errors.Is(err, provider.ErrInvalidSchema) || errors.Is(err, provider.ErrInvalidVariantData) ||
+			errors.Is(err, provider.ErrUnknownBandit) || errors.Is(err, provider.ErrInvalidBanditConfig) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, provider.ErrForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, provider.ErrQuotaExceeded) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		if errors.Is(err, provider.ErrApprovalRequired) || errors.Is(err, provider.ErrDeprecatedBandit) ||
+			errors.Is(err, provider.ErrProtectionDisabled) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, provider.ErrDefaultRuleExists) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -86,6 +215,12 @@ func (i *Implementation) UpdateRule(ctx context.Context, req *desc.ModifyRuleReq
 
 	r, err := i.ruleProvider.UpdateRule(ctx, encodeModifyRule(req))
 	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, provider.ErrForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -101,280 +236,1835 @@ func (i *Implementation) SetRuleState(ctx context.Context, req *desc.SetRuleStat
 	}
 
 	if err := i.ruleProvider.SetRuleState(ctx, req.GetId(), encodeStateType(req.GetState())); err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, provider.ErrForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, provider.ErrDefaultRuleExists) || errors.Is(err, provider.ErrApprovalRequired) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return nil, nil
 }
 
-func (i *Implementation) GetVariant(ctx context.Context, req *desc.GetVariantRequest) (*desc.VariantResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetVariant")
+func (i *Implementation) DeleteRule(ctx context.Context, req *desc.GetRuleRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/DeleteRule")
 	defer span.Finish()
 
-	if len(req.GetId()) == 0 || len(req.GetRuleId()) == 0 {
+	if len(req.GetId()) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty id")
 	}
 
-	v, err := i.ruleProvider.GetVariant(ctx, req.GetRuleId(), req.GetId())
-	if err != nil {
+	if err := i.ruleProvider.DeleteRule(ctx, req.GetId()); err != nil {
 		if errors.Is(err, provider.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
+		if errors.Is(err, provider.ErrForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return decodeVariantResponse(v), nil
+	return nil, nil
 }
 
-func (i *Implementation) GetVariantData(ctx context.Context, req *desc.GetVariantRequest) (*desc.VariantResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetVariantData")
+func (i *Implementation) SetRuleSchedule(ctx context.Context, req *desc.SetRuleScheduleRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/SetRuleSchedule")
 	defer span.Finish()
 
-	if len(req.GetId()) == 0 || len(req.GetRuleId()) == 0 {
+	if len(req.GetId()) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty id")
 	}
 
-	v, err := i.ruleProvider.GetVariant(ctx, req.GetRuleId(), req.GetId())
-	if err != nil {
+	startAt, endAt := encodeTimestamp(req.GetStartAt()), encodeTimestamp(req.GetEndAt())
+	if err := validateSchedule(startAt, endAt); err != nil {
+		return nil, err
+	}
+
+	if err := i.ruleProvider.SetRuleSchedule(ctx, req.GetId(), startAt, endAt); err != nil {
 		if errors.Is(err, provider.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
+		if errors.Is(err, provider.ErrForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, provider.ErrApprovalRequired) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &desc.VariantResponse{
-		Variant: &desc.Variant{Data: v.Data},
-	}, nil
+	return nil, nil
 }
 
-func (i *Implementation) AddVariant(ctx context.Context, req *desc.AddVariantRequest) (*desc.VariantResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "api/AddVariant")
+func (i *Implementation) SetRuleVariantSchema(ctx context.Context, req *desc.SetRuleVariantSchemaRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/SetRuleVariantSchema")
 	defer span.Finish()
 
-	if len(req.GetRuleId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "empty rule id")
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
 	}
 
-	r, err := i.ruleProvider.AddVariant(ctx, req.GetRuleId(), encodeVariant(req.GetVariant()))
-	if err != nil {
+	if err := i.ruleProvider.SetRuleVariantSchema(ctx, req.GetId(), req.GetVariantSchema()); err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, provider.ErrInvalidSchema) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, provider.ErrIncompatibleSchema) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return decodeVariantResponse(r), nil
+	return nil, nil
 }
 
-func (i *Implementation) SetVariantState(ctx context.Context, req *desc.SetVariantStateRequest) (*emptypb.Empty, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "api/SetVariantState")
+func (i *Implementation) SetRuleBanditConfig(ctx context.Context, req *desc.SetRuleBanditConfigRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/SetRuleBanditConfig")
 	defer span.Finish()
 
-	if len(req.GetId()) == 0 || len(req.GetRuleId()) == 0 {
+	if len(req.GetId()) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty id")
 	}
 
-	if err := i.ruleProvider.SetVariantState(ctx, req.GetRuleId(), req.GetId(), encodeStateType(req.GetState())); err != nil {
+	if err := i.ruleProvider.SetRuleBanditConfig(ctx, req.GetId(), req.GetBanditConfig()); err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, provider.ErrInvalidBanditConfig) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, provider.ErrUnknownBandit) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return nil, nil
 }
 
-func (i *Implementation) GetRuleServiceContext(ctx context.Context, req *desc.GetRuleRequest) (*desc.GetRuleServiceContextResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetRuleServiceContext")
+func (i *Implementation) SetRuleTargeting(ctx context.Context, req *desc.SetRuleTargetingRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/SetRuleTargeting")
 	defer span.Finish()
 
 	if len(req.GetId()) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty id")
 	}
 
-	service, context, err := i.ruleProvider.GetRuleServiceContext(ctx, req.GetId())
-	if err != nil {
+	if err := validateTargeting(req.GetTargeting()); err != nil {
+		return nil, err
+	}
+
+	if err := i.ruleProvider.SetRuleTargeting(ctx, req.GetId(), req.GetPriority(), encodeTargeting(req.GetTargeting())); err != nil {
 		if errors.Is(err, provider.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
+		if errors.Is(err, provider.ErrDefaultRuleExists) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &desc.GetRuleServiceContextResponse{
-		Service: service,
-		Context: context,
-	}, nil
+	return nil, nil
 }
 
-func (i *Implementation) CreateWantedBandit(ctx context.Context, req *desc.CreateWantedBanditRequest) (*emptypb.Empty, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "api/CreateWantedBandit")
+func (i *Implementation) SetRuleLayer(ctx context.Context, req *desc.SetRuleLayerRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/SetRuleLayer")
 	defer span.Finish()
 
-	if len(req.GetData().GetBanditKey()) == 0 || len(req.GetData().GetName()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "empty bantit key or name")
-	}
-
-	wb := model.WantedBandit{
-		BanditKey: req.GetData().GetBanditKey(),
-		Name:      req.GetData().GetName(),
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
 	}
 
-	err := i.ruleProvider.CreateWantedBandit(ctx, wb)
-	if err != nil {
+	if err := i.ruleProvider.SetRuleLayer(ctx, req.GetId(), req.GetLayerId()); err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return nil, nil
 }
 
-func (i *Implementation) GetWantedRegistry(ctx context.Context, req *emptypb.Empty) (*desc.GetWantedRegistryResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetWantedRegistry")
+func (i *Implementation) ListRuleRevisions(ctx context.Context, req *desc.GetRuleRequest) (*desc.ListRuleRevisionsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ListRuleRevisions")
 	defer span.Finish()
 
-	wr, err := i.ruleProvider.GetWantedRegistry(ctx)
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+
+	revisions, err := i.ruleProvider.ListRuleRevisions(ctx, req.GetId())
 	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	resp := &desc.GetWantedRegistryResponse{
-		Registry: make([]*desc.WantedBandit, len(wr)),
+	res := make([]*desc.RuleRevision, 0, len(revisions))
+	for _, r := range revisions {
+		res = append(res, decodeRuleRevision(r))
 	}
-	for i, b := range wr {
-		resp.Registry[i] = &desc.WantedBandit{
-			BanditKey: b.BanditKey,
-			Name:      b.Name,
-		}
-	}
-	return resp, nil
+
+	return &desc.ListRuleRevisionsResponse{
+		Revisions: res,
+	}, nil
 }
 
-func (i *Implementation) CheckRule(ctx context.Context, req *desc.CheckRequest) (*desc.CheckResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "api/CheckRule")
+func (i *Implementation) GetRuleRevision(ctx context.Context, req *desc.GetRuleRevisionRequest) (*desc.RuleRevisionResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetRuleRevision")
 	defer span.Finish()
 
-	if len(req.GetId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "empty id")
+	if len(req.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty rule id")
 	}
 
-	r, err := i.ruleProvider.GetRule(ctx, req.GetId())
+	r, err := i.ruleProvider.GetRuleRevision(ctx, req.GetRuleId(), req.GetRevision())
 	if err != nil {
 		if errors.Is(err, provider.ErrNotFound) {
-			return &desc.CheckResponse{IsExist: false}, nil
+			return nil, status.Error(codes.NotFound, err.Error())
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &desc.CheckResponse{IsExist: r.State == model.StateTypeEnable}, nil
+	return &desc.RuleRevisionResponse{
+		Revision: decodeRuleRevision(r),
+	}, nil
 }
 
-func (i *Implementation) CheckVariant(ctx context.Context, req *desc.CheckRequest) (*desc.CheckResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "api/CheckVariant")
+func (i *Implementation) DiffRuleRevisions(ctx context.Context, req *desc.DiffRuleRevisionsRequest) (*desc.DiffRuleRevisionsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/DiffRuleRevisions")
 	defer span.Finish()
 
-	if len(req.GetId()) == 0 || len(req.GetVariantId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "empty id")
+	if len(req.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty rule id")
 	}
 
-	v, err := i.ruleProvider.GetVariant(ctx, req.GetId(), req.GetVariantId())
+	changes, err := i.ruleProvider.DiffRuleRevisions(ctx, req.GetRuleId(), req.GetFromRevision(), req.GetToRevision())
 	if err != nil {
 		if errors.Is(err, provider.ErrNotFound) {
-			return &desc.CheckResponse{IsExist: false}, nil
+			return nil, status.Error(codes.NotFound, err.Error())
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &desc.CheckResponse{IsExist: v.State == model.StateTypeEnable}, nil
-}
-
-func encodeModifyRule(v *desc.ModifyRuleRequest) model.Rule {
-	return model.Rule{
-		Id:          v.Id,
-		Name:        v.Name,
-		Description: v.Description,
+	res := make([]*desc.RevisionChange, 0, len(changes))
+	for _, c := range changes {
+		res = append(res, &desc.RevisionChange{
+			Path: c.Path,
+			From: c.From,
+			To:   c.To,
+		})
 	}
+
+	return &desc.DiffRuleRevisionsResponse{
+		Changes: res,
+	}, nil
 }
 
-func encodeCreateRule(v *desc.CreateRuleRequest) model.Rule {
-	return model.Rule{
-		Name:        v.Name,
-		Description: v.Description,
-		BanditKey:   v.BanditKey,
-		Service:     v.Service,
-		Context:     v.Context,
-		State:       encodeStateType(v.GetState()),
-		Variants:    encodeVariants(v.GetVariants()),
+func (i *Implementation) RollbackRule(ctx context.Context, req *desc.RollbackRuleRequest) (*desc.RuleResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/RollbackRule")
+	defer span.Finish()
+
+	if len(req.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty rule id")
 	}
-}
 
-func decodeRuleResponse(r model.Rule) *desc.RuleResponse {
-	return &desc.RuleResponse{
-		Rule: decodeRule(r),
+	r, err := i.ruleProvider.RollbackRule(ctx, req.GetRuleId(), req.GetRevision())
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, provider.ErrDefaultRuleExists) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, provider.ErrQuotaExceeded) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		if errors.Is(err, provider.ErrForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, provider.ErrApprovalRequired) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
+
+	return decodeRuleResponse(r), nil
 }
 
-func decodeRule(r model.Rule) *desc.Rule {
-	return &desc.Rule{
-		Id:          r.Id,
-		Name:        r.Name,
-		Description: r.Description,
-		BanditKey:   r.BanditKey,
-		Context:     r.Context,
-		Service:     r.Service,
-		State:       decodeStateType(r.State),
-		Variants:    decodeVariants(r.Variants),
+func (i *Implementation) CreateLayer(ctx context.Context, req *desc.CreateLayerRequest) (*desc.LayerResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/CreateLayer")
+	defer span.Finish()
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty name")
 	}
-}
 
-func decodeStateType(v model.StateType) desc.State {
-	switch v {
-	case model.StateTypeEnable:
-		return desc.State_STATE_ENABLED
-	case model.StateTypeDisable:
-		return desc.State_STATE_DISABLED
-	default:
-		return desc.State_STATE_UNSPECIFIED
+	l, err := i.ruleProvider.CreateLayer(ctx, model.Layer{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
+
+	return &desc.LayerResponse{Layer: decodeLayer(l)}, nil
 }
 
-func encodeStateType(v desc.State) model.StateType {
-	switch v {
-	case desc.State_STATE_ENABLED:
-		return model.StateTypeEnable
-	default:
-		return model.StateTypeDisable
+func (i *Implementation) GetLayer(ctx context.Context, req *desc.GetLayerRequest) (*desc.LayerResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetLayer")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
 	}
-}
 
-func decodeVariants(in []model.Variant) []*desc.Variant {
-	out := make([]*desc.Variant, len(in))
-	for i, v := range in {
-		out[i] = decodeVariant(v)
+	l, err := i.ruleProvider.GetLayer(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return out
+
+	return &desc.LayerResponse{Layer: decodeLayer(l)}, nil
 }
 
-func decodeVariant(v model.Variant) *desc.Variant {
-	return &desc.Variant{
-		Id:    v.Id,
-		Name:  v.Name,
-		Data:  v.Data,
-		State: decodeStateType(v.State),
+func (i *Implementation) GetVariant(ctx context.Context, req *desc.GetVariantRequest) (*desc.VariantResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetVariant")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 || len(req.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
 	}
-}
 
-func encodeVariant(v *desc.Variant) model.Variant {
-	return model.Variant{
-		Id:    v.GetId(),
-		Name:  v.GetName(),
-		Data:  v.GetData(),
-		State: encodeStateType(v.State),
+	v, err := i.ruleProvider.GetVariant(ctx, req.GetRuleId(), req.GetId())
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
+
+	return decodeVariantResponse(v), nil
 }
 
-func encodeVariants(in []*desc.Variant) []model.Variant {
-	out := make([]model.Variant, len(in))
-	for i, v := range in {
-		out[i] = encodeVariant(v)
+func (i *Implementation) GetVariantData(ctx context.Context, req *desc.GetVariantRequest) (*desc.VariantResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetVariantData")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 || len(req.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
 	}
-	return out
-}
 
-func decodeVariantResponse(v model.Variant) *desc.VariantResponse {
-	return &desc.VariantResponse{
-		Variant: decodeVariant(v),
+	v, err := i.ruleProvider.GetVariant(ctx, req.GetRuleId(), req.GetId())
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.VariantResponse{
+		Variant: &desc.Variant{Data: v.Data},
+	}, nil
+}
+
+func (i *Implementation) AddVariant(ctx context.Context, req *desc.AddVariantRequest) (*desc.VariantResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/AddVariant")
+	defer span.Finish()
+
+	if len(req.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty rule id")
+	}
+
+	if err := validateSchedule(encodeTimestamp(req.GetVariant().GetStartAt()), encodeTimestamp(req.GetVariant().GetEndAt())); err != nil {
+		return nil, err
+	}
+
+	r, err := i.ruleProvider.AddVariant(ctx, req.GetRuleId(), encodeVariant(req.GetVariant()))
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, provider.ErrInvalidSchema) || errors.Is(err, provider.ErrInvalidVariantData) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, provider.ErrQuotaExceeded) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		if errors.Is(err, provider.ErrForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, provider.ErrApprovalRequired) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return decodeVariantResponse(r), nil
+}
+
+func (i *Implementation) UpdateVariant(ctx context.Context, req *desc.UpdateVariantRequest) (*desc.VariantResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/UpdateVariant")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 || len(req.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id or rule_id")
+	}
+
+	v, err := i.ruleProvider.UpdateVariant(ctx, req.GetRuleId(), model.Variant{
+		Id:   req.GetId(),
+		Name: req.GetName(),
+		Data: req.GetData(),
+	})
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, provider.ErrInvalidSchema) || errors.Is(err, provider.ErrInvalidVariantData) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return decodeVariantResponse(v), nil
+}
+
+func (i *Implementation) DeleteVariant(ctx context.Context, req *desc.RemoveVariantRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/DeleteVariant")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 || len(req.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id or rule_id")
+	}
+
+	if err := i.ruleProvider.DeleteVariant(ctx, req.GetRuleId(), req.GetId()); err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return nil, nil
+}
+
+func (i *Implementation) SetVariantState(ctx context.Context, req *desc.SetVariantStateRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/SetVariantState")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 || len(req.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+
+	if err := i.ruleProvider.SetVariantState(ctx, req.GetRuleId(), req.GetId(), encodeStateType(req.GetState())); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return nil, nil
+}
+
+func (i *Implementation) SetVariantSchedule(ctx context.Context, req *desc.SetVariantScheduleRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/SetVariantSchedule")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 || len(req.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id or rule_id")
+	}
+
+	startAt, endAt := encodeTimestamp(req.GetStartAt()), encodeTimestamp(req.GetEndAt())
+	if err := validateSchedule(startAt, endAt); err != nil {
+		return nil, err
+	}
+
+	if err := i.ruleProvider.SetVariantSchedule(ctx, req.GetRuleId(), req.GetId(), startAt, endAt); err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return nil, nil
+}
+
+func (i *Implementation) AddGuardrail(ctx context.Context, req *desc.AddGuardrailRequest) (*desc.GuardrailResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/AddGuardrail")
+	defer span.Finish()
+
+	g := req.GetGuardrail()
+	if len(g.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty rule id")
+	}
+	if g.GetMetric() == desc.GuardrailMetric_GUARDRAIL_METRIC_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "unspecified metric")
+	}
+	if g.GetWindow().AsDuration() < time.Second {
+		return nil, status.Error(codes.InvalidArgument, "window must be at least 1s")
+	}
+	if g.GetMetric() == desc.GuardrailMetric_GUARDRAIL_METRIC_ERROR_RATE && (g.GetThreshold() < 0 || g.GetThreshold() > 1) {
+		return nil, status.Error(codes.InvalidArgument, "error rate threshold must be in [0, 1]")
+	}
+
+	r, err := i.ruleProvider.AddGuardrail(ctx, encodeGuardrail(g))
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.GuardrailResponse{Guardrail: decodeGuardrail(r)}, nil
+}
+
+func (i *Implementation) RemoveGuardrail(ctx context.Context, req *desc.RemoveGuardrailRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/RemoveGuardrail")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+
+	if err := i.ruleProvider.RemoveGuardrail(ctx, req.GetId()); err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return nil, nil
+}
+
+func (i *Implementation) GetRuleGuardrails(ctx context.Context, req *desc.GetRuleRequest) (*desc.GetGuardrailsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetRuleGuardrails")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+
+	g, err := i.ruleProvider.GetRuleGuardrails(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.GetGuardrailsResponse{Guardrails: decodeGuardrails(g)}, nil
+}
+
+func (i *Implementation) ListGuardrails(ctx context.Context, req *emptypb.Empty) (*desc.GetGuardrailsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ListGuardrails")
+	defer span.Finish()
+
+	g, err := i.ruleProvider.ListGuardrails(ctx)
+	if err != nil {
+		if errors.Is(err, provider.ErrForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.GetGuardrailsResponse{Guardrails: decodeGuardrails(g)}, nil
+}
+
+func (i *Implementation) GetRuleServiceContext(ctx context.Context, req *desc.GetRuleRequest) (*desc.GetRuleServiceContextResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetRuleServiceContext")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+
+	r, err := i.ruleProvider.GetRuleServiceContext(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.GetRuleServiceContextResponse{
+		Service:   r.Service,
+		Context:   r.Context,
+		LayerId:   r.LayerId,
+		Priority:  r.Priority,
+		Targeting: decodeTargeting(r.Targeting),
+		State:     decodeStateType(r.State),
+	}, nil
+}
+
+func (i *Implementation) CreateWantedBandit(ctx context.Context, req *desc.CreateWantedBanditRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/CreateWantedBandit")
+	defer span.Finish()
+
+	if len(req.GetData().GetBanditKey()) == 0 || len(req.GetData().GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty bantit key or name")
+	}
+
+	err := i.ruleProvider.CreateWantedBandit(ctx, encodeWantedBandit(req.GetData()))
+	if err != nil {
+		return nil, wantedBanditError(err)
+	}
+
+	return nil, nil
+}
+
+func (i *Implementation) GetWantedRegistry(ctx context.Context, req *emptypb.Empty) (*desc.GetWantedRegistryResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetWantedRegistry")
+	defer span.Finish()
+
+	wr, err := i.ruleProvider.GetWantedRegistry(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &desc.GetWantedRegistryResponse{
+		Registry: make([]*desc.WantedBandit, len(wr)),
+	}
+	for i, b := range wr {
+		resp.Registry[i] = decodeWantedBandit(b)
+	}
+	return resp, nil
+}
+
+func (i *Implementation) GetWantedBandit(ctx context.Context, req *desc.GetWantedBanditRequest) (*desc.WantedBanditResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetWantedBandit")
+	defer span.Finish()
+
+	if len(req.GetBanditKey()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty bandit key")
+	}
+
+	wb, err := i.ruleProvider.GetWantedBandit(ctx, req.GetBanditKey())
+	if err != nil {
+		return nil, wantedBanditError(err)
+	}
+
+	return &desc.WantedBanditResponse{Bandit: decodeWantedBandit(wb)}, nil
+}
+
+func (i *Implementation) UpdateWantedBandit(ctx context.Context, req *desc.UpdateWantedBanditRequest) (*desc.WantedBanditResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/UpdateWantedBandit")
+	defer span.Finish()
+
+	if len(req.GetData().GetBanditKey()) == 0 || len(req.GetData().GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty bandit key or name")
+	}
+
+	wb, err := i.ruleProvider.UpdateWantedBandit(ctx, encodeWantedBandit(req.GetData()))
+	if err != nil {
+		return nil, wantedBanditError(err)
+	}
+
+	return &desc.WantedBanditResponse{Bandit: decodeWantedBandit(wb)}, nil
+}
+
+func (i *Implementation) DeprecateWantedBandit(ctx context.Context, req *desc.DeprecateWantedBanditRequest) (*desc.DeprecateWantedBanditResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/DeprecateWantedBandit")
+	defer span.Finish()
+
+	if len(req.GetBanditKey()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty bandit key")
+	}
+
+	wb, rules, err := i.ruleProvider.DeprecateWantedBandit(ctx, req.GetBanditKey(), !req.GetRestore(), req.GetReason())
+	if err != nil {
+		return nil, wantedBanditError(err)
+	}
+
+	resp := &desc.DeprecateWantedBanditResponse{
+		Bandit:        decodeWantedBandit(wb),
+		AffectedRules: make([]*desc.Rule, 0, len(rules)),
+	}
+	for _, r := range rules {
+		resp.AffectedRules = append(resp.AffectedRules, decodeRule(r))
+	}
+
+	return resp, nil
+}
+
+func (i *Implementation) ListStaleBanditRules(ctx context.Context, req *emptypb.Empty) (*desc.ListStaleBanditRulesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ListStaleBanditRules")
+	defer span.Finish()
+
+	stale, err := i.ruleProvider.ListStaleBanditRules(ctx)
+	if err != nil {
+		return nil, wantedBanditError(err)
+	}
+
+	resp := &desc.ListStaleBanditRulesResponse{
+		Rules: make([]*desc.StaleBanditRule, 0, len(stale)),
+	}
+	for _, sr := range stale {
+		resp.Rules = append(resp.Rules, &desc.StaleBanditRule{
+			Rule:  decodeRule(sr.Rule),
+			Issue: decodeBanditKeyIssue(sr.Issue),
+		})
+	}
+
+	return resp, nil
+}
+
+func (i *Implementation) ListAuditEvents(ctx context.Context, req *desc.ListAuditEventsRequest) (*desc.ListAuditEventsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ListAuditEvents")
+	defer span.Finish()
+
+	filter := model.AuditFilter{
+		RuleId: req.GetRuleId(),
+		Actor:  req.GetActor(),
+		From:   encodeTimestamp(req.GetFrom()),
+		To:     encodeTimestamp(req.GetTo()),
+		Limit:  defaultPageSize,
+	}
+	if req.GetLimit() > 0 {
+		filter.Limit = min(int(req.GetLimit()), maxPageSize)
+	}
+
+	events, err := i.ruleProvider.ListAuditEvents(ctx, filter)
+	if err != nil {
+		if errors.Is(err, provider.ErrForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := make([]*desc.AuditEvent, 0, len(events))
+	for _, e := range events {
+		res = append(res, &desc.AuditEvent{
+			Id:        e.Id,
+			Actor:     e.Actor,
+			Method:    e.Method,
+			RuleId:    e.RuleId,
+			Request:   e.Request,
+			PrevState: e.PrevState,
+			NewState:  e.NewState,
+			Error:     e.Error,
+			CreatedAt: decodeTimestamp(nonZeroTime(e.CreatedAt)),
+		})
+	}
+
+	return &desc.ListAuditEventsResponse{
+		Events: res,
+	}, nil
+}
+
+func (i *Implementation) CreateTenant(ctx context.Context, req *desc.Tenant) (*desc.TenantResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/CreateTenant")
+	defer span.Finish()
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty name")
+	}
+
+	t, err := i.ruleProvider.CreateTenant(ctx, encodeTenant(req))
+	if err != nil {
+		return nil, tenantError(err)
+	}
+
+	return &desc.TenantResponse{Tenant: decodeTenant(t)}, nil
+}
+
+func (i *Implementation) UpdateTenant(ctx context.Context, req *desc.Tenant) (*desc.TenantResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/UpdateTenant")
+	defer span.Finish()
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty name")
+	}
+
+	t, err := i.ruleProvider.UpdateTenant(ctx, encodeTenant(req))
+	if err != nil {
+		return nil, tenantError(err)
+	}
+
+	return &desc.TenantResponse{Tenant: decodeTenant(t)}, nil
+}
+
+func (i *Implementation) SetTenantServices(ctx context.Context, req *desc.SetTenantServicesRequest) (*desc.TenantResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/SetTenantServices")
+	defer span.Finish()
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty name")
+	}
+
+	t, err := i.ruleProvider.SetTenantServices(ctx, req.GetName(), req.GetServices())
+	if err != nil {
+		return nil, tenantError(err)
+	}
+
+	return &desc.TenantResponse{Tenant: decodeTenant(t)}, nil
+}
+
+func (i *Implementation) GetTenant(ctx context.Context, req *desc.GetTenantRequest) (*desc.TenantResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetTenant")
+	defer span.Finish()
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty name")
+	}
+
+	t, err := i.ruleProvider.GetTenant(ctx, req.GetName())
+	if err != nil {
+		return nil, tenantError(err)
+	}
+
+	return &desc.TenantResponse{Tenant: decodeTenant(t)}, nil
+}
+
+func (i *Implementation) ListTenants(ctx context.Context, req *emptypb.Empty) (*desc.ListTenantsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ListTenants")
+	defer span.Finish()
+
+	tenants, err := i.ruleProvider.ListTenants(ctx)
+	if err != nil {
+		return nil, tenantError(err)
+	}
+
+	res := make([]*desc.Tenant, 0, len(tenants))
+	for _, t := range tenants {
+		res = append(res, decodeTenant(t))
+	}
+
+	return &desc.ListTenantsResponse{Tenants: res}, nil
+}
+
+func (i *Implementation) SetRuleProtected(ctx context.Context, req *desc.SetRuleProtectedRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/SetRuleProtected")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+
+	if err := i.ruleProvider.SetRuleProtected(ctx, req.GetId(), req.GetProtected()); err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, provider.ErrProtectionDisabled) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return nil, nil
+}
+
+func (i *Implementation) MigrateRuleBandit(ctx context.Context, req *desc.MigrateRuleBanditRequest) (*desc.RuleResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/MigrateRuleBandit")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 || len(req.GetBanditKey()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id or bandit key")
+	}
+
+	r, err := i.ruleProvider.MigrateRuleBandit(ctx, req.GetId(), req.GetBanditKey())
+	if err != nil {
+		switch {
+		case errors.Is(err, provider.ErrNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, provider.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, provider.ErrInvalidMigration), errors.Is(err, provider.ErrUnknownBandit),
+			errors.Is(err, provider.ErrInvalidBanditConfig):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, provider.ErrDeprecatedBandit), errors.Is(err, provider.ErrApprovalRequired):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return decodeRuleResponse(r), nil
+}
+
+func (i *Implementation) ResetStatistics(ctx context.Context, req *desc.ResetStatisticsRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ResetStatistics")
+	defer span.Finish()
+
+	if len(req.GetRuleId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty rule id")
+	}
+
+	if err := i.ruleProvider.ResetStatistics(ctx, req.GetRuleId(), req.GetVariantId()); err != nil {
+		switch {
+		case errors.Is(err, provider.ErrNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, provider.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, provider.ErrApprovalRequired):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return nil, nil
+}
+
+func (i *Implementation) ListChangeRequests(ctx context.Context, req *desc.ListChangeRequestsRequest) (*desc.ListChangeRequestsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ListChangeRequests")
+	defer span.Finish()
+
+	filter := model.ChangeRequestFilter{
+		RuleId: req.GetRuleId(),
+		Status: encodeChangeStatus(req.GetStatus()),
+		Limit:  defaultPageSize,
+	}
+	if req.GetLimit() > 0 {
+		filter.Limit = min(int(req.GetLimit()), maxPageSize)
+	}
+
+	crs, err := i.ruleProvider.ListChangeRequests(ctx, filter)
+	if err != nil {
+		return nil, changeRequestError(err)
+	}
+
+	res := make([]*desc.ChangeRequest, 0, len(crs))
+	for _, cr := range crs {
+		res = append(res, decodeChangeRequest(cr))
+	}
+
+	return &desc.ListChangeRequestsResponse{ChangeRequests: res}, nil
+}
+
+func (i *Implementation) GetChangeRequest(ctx context.Context, req *desc.GetChangeRequestRequest) (*desc.ChangeRequestResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetChangeRequest")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+
+	cr, err := i.ruleProvider.GetChangeRequest(ctx, req.GetId())
+	if err != nil {
+		return nil, changeRequestError(err)
+	}
+
+	return &desc.ChangeRequestResponse{ChangeRequest: decodeChangeRequest(cr)}, nil
+}
+
+func (i *Implementation) SubmitChangeRequest(ctx context.Context, req *desc.GetChangeRequestRequest) (*desc.ChangeRequestResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/SubmitChangeRequest")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+
+	cr, err := i.ruleProvider.SubmitChangeRequest(ctx, req.GetId())
+	if err != nil {
+		return nil, changeRequestError(err)
+	}
+
+	return &desc.ChangeRequestResponse{ChangeRequest: decodeChangeRequest(cr)}, nil
+}
+
+func (i *Implementation) ApproveChangeRequest(ctx context.Context, req *desc.ReviewChangeRequestRequest) (*desc.ChangeRequestResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ApproveChangeRequest")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+
+	cr, err := i.ruleProvider.ApproveChangeRequest(ctx, req.GetId(), req.GetComment())
+	if err != nil {
+		return nil, changeRequestError(err)
+	}
+
+	return &desc.ChangeRequestResponse{ChangeRequest: decodeChangeRequest(cr)}, nil
+}
+
+func (i *Implementation) RejectChangeRequest(ctx context.Context, req *desc.ReviewChangeRequestRequest) (*desc.ChangeRequestResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/RejectChangeRequest")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+
+	cr, err := i.ruleProvider.RejectChangeRequest(ctx, req.GetId(), req.GetComment())
+	if err != nil {
+		return nil, changeRequestError(err)
+	}
+
+	return &desc.ChangeRequestResponse{ChangeRequest: decodeChangeRequest(cr)}, nil
+}
+
+func (i *Implementation) ApplyChangeRequest(ctx context.Context, req *desc.GetChangeRequestRequest) (*desc.ChangeRequestResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ApplyChangeRequest")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+
+	cr, err := i.ruleProvider.ApplyChangeRequest(ctx, req.GetId())
+	if err != nil {
+		return nil, changeRequestError(err)
+	}
+
+	return &desc.ChangeRequestResponse{ChangeRequest: decodeChangeRequest(cr)}, nil
+}
+
+func (i *Implementation) CloneRule(ctx context.Context, req *desc.CloneRuleRequest) (*desc.RuleResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/CloneRule")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 || len(req.GetService()) == 0 || len(req.GetContext()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id, service or context")
+	}
+
+	r, err := i.ruleProvider.CloneRule(ctx, req.GetId(), model.CloneTarget{
+		Service:         req.GetService(),
+		Context:         req.GetContext(),
+		Name:            req.GetName(),
+		State:           encodeStateType(req.GetState()),
+		CarryStatistics: req.GetCarryStatistics(),
+	})
+	if err != nil {
+		return nil, templateError(err)
+	}
+
+	return decodeRuleResponse(r), nil
+}
+
+func (i *Implementation) CreateRuleTemplate(ctx context.Context, req *desc.RuleTemplate) (*desc.RuleTemplateResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/CreateRuleTemplate")
+	defer span.Finish()
+
+	if len(req.GetName()) == 0 || len(req.GetBanditKey()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty name or bandit_key")
+	}
+
+	if err := validateTargeting(req.GetTargeting()); err != nil {
+		return nil, err
+	}
+
+	t, err := i.ruleProvider.CreateRuleTemplate(ctx, encodeRuleTemplate(req))
+	if err != nil {
+		return nil, templateError(err)
+	}
+
+	return &desc.RuleTemplateResponse{Template: decodeRuleTemplate(t)}, nil
+}
+
+func (i *Implementation) GetRuleTemplate(ctx context.Context, req *desc.GetRuleTemplateRequest) (*desc.RuleTemplateResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/GetRuleTemplate")
+	defer span.Finish()
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty name")
+	}
+
+	t, err := i.ruleProvider.GetRuleTemplate(ctx, req.GetName())
+	if err != nil {
+		return nil, templateError(err)
+	}
+
+	return &desc.RuleTemplateResponse{Template: decodeRuleTemplate(t)}, nil
+}
+
+func (i *Implementation) ListRuleTemplates(ctx context.Context, req *emptypb.Empty) (*desc.ListRuleTemplatesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ListRuleTemplates")
+	defer span.Finish()
+
+	templates, err := i.ruleProvider.ListRuleTemplates(ctx)
+	if err != nil {
+		return nil, templateError(err)
+	}
+
+	res := make([]*desc.RuleTemplate, 0, len(templates))
+	for _, t := range templates {
+		res = append(res, decodeRuleTemplate(t))
+	}
+
+	return &desc.ListRuleTemplatesResponse{Templates: res}, nil
+}
+
+func (i *Implementation) DeleteRuleTemplate(ctx context.Context, req *desc.GetRuleTemplateRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/DeleteRuleTemplate")
+	defer span.Finish()
+
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty name")
+	}
+
+	if err := i.ruleProvider.DeleteRuleTemplate(ctx, req.GetName()); err != nil {
+		return nil, templateError(err)
+	}
+
+	return nil, nil
+}
+
+func (i *Implementation) InstantiateRuleTemplate(ctx context.Context, req *desc.InstantiateRuleTemplateRequest) (*desc.RuleResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/InstantiateRuleTemplate")
+	defer span.Finish()
+
+	if len(req.GetName()) == 0 || len(req.GetRuleName()) == 0 || len(req.GetService()) == 0 || len(req.GetContext()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty name, rule_name, service or context")
+	}
+
+	r, err := i.ruleProvider.InstantiateRuleTemplate(ctx, req.GetName(), model.TemplateInstance{
+		Service:     req.GetService(),
+		Context:     req.GetContext(),
+		Name:        req.GetRuleName(),
+		Description: req.GetDescription(),
+		State:       encodeStateType(req.GetState()),
+		Params:      req.GetParams(),
+	})
+	if err != nil {
+		return nil, templateError(err)
+	}
+
+	return decodeRuleResponse(r), nil
+}
+
+func (i *Implementation) ExportRules(ctx context.Context, req *desc.ExportRulesRequest) (*desc.ExportRulesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ExportRules")
+	defer span.Finish()
+
+	rules, err := i.ruleProvider.ExportRules(ctx, req.GetService(), req.GetContext())
+	if err != nil {
+		return nil, importError(err)
+	}
+
+	raw, err := bundle.Encode(bundle.New(rules), encodeDocumentFormat(req.GetFormat()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.ExportRulesResponse{Document: string(raw)}, nil
+}
+
+func (i *Implementation) ImportRules(ctx context.Context, req *desc.ImportRulesRequest) (*desc.ImportRulesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/ImportRules")
+	defer span.Finish()
+
+	if len(req.GetDocument()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty document")
+	}
+
+	doc, err := bundle.Decode([]byte(req.GetDocument()), encodeDocumentFormat(req.GetFormat()))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	changes, err := i.ruleProvider.ImportRules(ctx, doc.ModelRules(), model.ImportOptions{
+		DryRun:           req.GetDryRun(),
+		Prune:            req.GetPrune(),
+		GuardDestructive: req.GetGuardDestructive(),
+		AllowDestructive: doc.AllowedDestructive(),
+	})
+	if err != nil {
+		return nil, importError(err)
+	}
+
+	res := make([]*desc.ImportChange, 0, len(changes))
+	for _, c := range changes {
+		res = append(res, &desc.ImportChange{
+			Action:      decodeImportAction(c.Action),
+			RuleId:      c.RuleId,
+			Service:     c.Service,
+			Context:     c.Context,
+			Name:        c.Name,
+			Details:     c.Details,
+			Destructive: c.Destructive,
+		})
+	}
+
+	return &desc.ImportRulesResponse{Changes: res, Applied: !req.GetDryRun() && len(res) > 0}, nil
+}
+
+func (i *Implementation) CheckRule(ctx context.Context, req *desc.CheckRequest) (*desc.CheckResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/CheckRule")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+
+	r, err := i.ruleProvider.GetRule(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return &desc.CheckResponse{IsExist: false}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.CheckResponse{IsExist: r.State == model.StateTypeEnable}, nil
+}
+
+func (i *Implementation) CheckVariant(ctx context.Context, req *desc.CheckRequest) (*desc.CheckResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api/CheckVariant")
+	defer span.Finish()
+
+	if len(req.GetId()) == 0 || len(req.GetVariantId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty id")
+	}
+
+	v, err := i.ruleProvider.GetVariant(ctx, req.GetId(), req.GetVariantId())
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return &desc.CheckResponse{IsExist: false}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &desc.CheckResponse{IsExist: v.State == model.StateTypeEnable}, nil
+}
+
+func encodeModifyRule(v *desc.ModifyRuleRequest) model.Rule {
+	return model.Rule{
+		Id:          v.Id,
+		Name:        v.Name,
+		Description: v.Description,
+	}
+}
+
+func encodeCreateRule(v *desc.CreateRuleRequest) model.Rule {
+	return model.Rule{
+		Name:          v.Name,
+		Description:   v.Description,
+		BanditKey:     v.BanditKey,
+		Service:       v.Service,
+		Context:       v.Context,
+		LayerId:       v.GetLayerId(),
+		StartAt:       encodeTimestamp(v.GetStartAt()),
+		EndAt:         encodeTimestamp(v.GetEndAt()),
+		Priority:      v.GetPriority(),
+		Targeting:     encodeTargeting(v.GetTargeting()),
+		VariantSchema: v.GetVariantSchema(),
+		Protected:     v.GetProtected(),
+		BanditConfig:  v.GetBanditConfig(),
+		State:         encodeStateType(v.GetState()),
+		Variants:      encodeVariants(v.GetVariants()),
+	}
+}
+
+func decodeRuleResponse(r model.Rule) *desc.RuleResponse {
+	return &desc.RuleResponse{
+		Rule: decodeRule(r),
+	}
+}
+
+func decodeRule(r model.Rule) *desc.Rule {
+	return &desc.Rule{
+		Id:            r.Id,
+		Name:          r.Name,
+		Description:   r.Description,
+		BanditKey:     r.BanditKey,
+		Context:       r.Context,
+		Service:       r.Service,
+		LayerId:       r.LayerId,
+		StartAt:       decodeTimestamp(r.StartAt),
+		EndAt:         decodeTimestamp(r.EndAt),
+		Priority:      r.Priority,
+		Targeting:     decodeTargeting(r.Targeting),
+		VariantSchema: r.VariantSchema,
+		CreatedAt:     decodeTimestamp(nonZeroTime(r.CreatedAt)),
+		UpdatedAt:     decodeTimestamp(nonZeroTime(r.UpdatedAt)),
+		Protected:     r.Protected,
+		BanditConfig:  r.BanditConfig,
+		State:         decodeStateType(r.State),
+		Variants:      decodeVariants(r.Variants),
+	}
+}
+
+func decodeRuleRevision(r model.RuleRevision) *desc.RuleRevision {
+	return &desc.RuleRevision{
+		Revision:  r.Revision,
+		RuleId:    r.RuleId,
+		Rule:      decodeRule(r.Rule),
+		Reason:    r.Reason,
+		CreatedAt: decodeTimestamp(nonZeroTime(r.CreatedAt)),
+	}
+}
+
+func encodeTenant(t *desc.Tenant) model.Tenant {
+	return model.Tenant{
+		Name:        t.GetName(),
+		Description: t.GetDescription(),
+		Services:    t.GetServices(),
+		MaxRules:    int(t.GetMaxRules()),
+		MaxVariants: int(t.GetMaxVariants()),
+	}
+}
+
+func decodeTenant(t model.Tenant) *desc.Tenant {
+	return &desc.Tenant{
+		Name:         t.Name,
+		Description:  t.Description,
+		Services:     t.Services,
+		MaxRules:     uint32(t.MaxRules),
+		MaxVariants:  uint32(t.MaxVariants),
+		RuleCount:    uint32(t.RuleCount),
+		VariantCount: uint32(t.VariantCount),
+	}
+}
+
+func tenantError(err error) error {
+	switch {
+	case errors.Is(err, provider.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, provider.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, provider.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func decodeChangeRequest(cr model.ChangeRequest) *desc.ChangeRequest {
+	return &desc.ChangeRequest{
+		Id:        cr.Id,
+		Operation: decodeChangeOperation(cr.Operation),
+		RuleId:    cr.RuleId,
+		Service:   cr.Service,
+		Payload:   string(cr.Payload),
+		Status:    decodeChangeStatus(cr.Status),
+		Author:    cr.Author,
+		Reviewer:  cr.Reviewer,
+		Comment:   cr.Comment,
+		CreatedAt: decodeTimestamp(nonZeroTime(cr.CreatedAt)),
+		UpdatedAt: decodeTimestamp(nonZeroTime(cr.UpdatedAt)),
+	}
+}
+
+func decodeChangeOperation(v model.ChangeOperation) desc.ChangeOperation {
+	switch v {
+	case model.ChangeOperationCreateRule:
+		return desc.ChangeOperation_CHANGE_OPERATION_CREATE_RULE
+	case model.ChangeOperationAddVariant:
+		return desc.ChangeOperation_CHANGE_OPERATION_ADD_VARIANT
+	case model.ChangeOperationSetRuleState:
+		return desc.ChangeOperation_CHANGE_OPERATION_SET_RULE_STATE
+	case model.ChangeOperationMigrateBandit:
+		return desc.ChangeOperation_CHANGE_OPERATION_MIGRATE_BANDIT
+	case model.ChangeOperationResetStats:
+		return desc.ChangeOperation_CHANGE_OPERATION_RESET_STATS
+	case model.ChangeOperationRollbackRule:
+		return desc.ChangeOperation_CHANGE_OPERATION_ROLLBACK_RULE
+	case model.ChangeOperationImportRule:
+		return desc.ChangeOperation_CHANGE_OPERATION_IMPORT_RULE
+	default:
+		return desc.ChangeOperation_CHANGE_OPERATION_UNSPECIFIED
+	}
+}
+
+func decodeChangeStatus(v model.ChangeStatus) desc.ChangeStatus {
+	switch v {
+	case model.ChangeStatusDraft:
+		return desc.ChangeStatus_CHANGE_STATUS_DRAFT
+	case model.ChangeStatusPendingReview:
+		return desc.ChangeStatus_CHANGE_STATUS_PENDING_REVIEW
+	case model.ChangeStatusApproved:
+		return desc.ChangeStatus_CHANGE_STATUS_APPROVED
+	case model.ChangeStatusApplied:
+		return desc.ChangeStatus_CHANGE_STATUS_APPLIED
+	case model.ChangeStatusRejected:
+		return desc.ChangeStatus_CHANGE_STATUS_REJECTED
+	default:
+		return desc.ChangeStatus_CHANGE_STATUS_UNSPECIFIED
+	}
+}
+
+func encodeChangeStatus(v desc.ChangeStatus) model.ChangeStatus {
+	switch v {
+	case desc.ChangeStatus_CHANGE_STATUS_DRAFT:
+		return model.ChangeStatusDraft
+	case desc.ChangeStatus_CHANGE_STATUS_PENDING_REVIEW:
+		return model.ChangeStatusPendingReview
+	case desc.ChangeStatus_CHANGE_STATUS_APPROVED:
+		return model.ChangeStatusApproved
+	case desc.ChangeStatus_CHANGE_STATUS_APPLIED:
+		return model.ChangeStatusApplied
+	case desc.ChangeStatus_CHANGE_STATUS_REJECTED:
+		return model.ChangeStatusRejected
+	default:
+		return ""
+	}
+}
+
+func changeRequestError(err error) error {
+	switch {
+	case errors.Is(err, provider.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, provider.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, provider.ErrInvalidChangeStatus), errors.Is(err, provider.ErrDefaultRuleExists),
+		errors.Is(err, provider.ErrDeprecatedBandit):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, provider.ErrInvalidSchema), errors.Is(err, provider.ErrInvalidVariantData),
+		errors.Is(err, provider.ErrUnknownBandit), errors.Is(err, provider.ErrInvalidBanditConfig):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, provider.ErrQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func encodeRuleTemplate(t *desc.RuleTemplate) model.RuleTemplate {
+	variants := make([]model.TemplateVariant, 0, len(t.GetVariants()))
+	for _, v := range t.GetVariants() {
+		variants = append(variants, model.TemplateVariant{Name: v.GetName(), Data: v.GetData()})
+	}
+
+	return model.RuleTemplate{
+		Name:          t.GetName(),
+		Description:   t.GetDescription(),
+		BanditKey:     t.GetBanditKey(),
+		VariantSchema: t.GetVariantSchema(),
+		Priority:      t.GetPriority(),
+		Targeting:     encodeTargeting(t.GetTargeting()),
+		Variants:      variants,
+	}
+}
+
+func decodeRuleTemplate(t model.RuleTemplate) *desc.RuleTemplate {
+	variants := make([]*desc.TemplateVariant, 0, len(t.Variants))
+	for _, v := range t.Variants {
+		variants = append(variants, &desc.TemplateVariant{Name: v.Name, Data: v.Data})
+	}
+
+	return &desc.RuleTemplate{
+		Name:          t.Name,
+		Description:   t.Description,
+		BanditKey:     t.BanditKey,
+		VariantSchema: t.VariantSchema,
+		Priority:      t.Priority,
+		Targeting:     decodeTargeting(t.Targeting),
+		Variants:      variants,
+		Parameters:    t.Parameters,
+		CreatedAt:     decodeTimestamp(nonZeroTime(t.CreatedAt)),
+		UpdatedAt:     decodeTimestamp(nonZeroTime(t.UpdatedAt)),
+	}
+}
+
+func encodeDocumentFormat(v desc.DocumentFormat) bundle.Format {
+	switch v {
+	case desc.DocumentFormat_DOCUMENT_FORMAT_JSON:
+		return bundle.FormatJSON
+	default:
+		return bundle.FormatYAML
+	}
+}
+
+func decodeImportAction(v model.ImportAction) desc.ImportAction {
+	switch v {
+	case model.ImportActionCreate:
+		return desc.ImportAction_IMPORT_ACTION_CREATE
+	case model.ImportActionUpdate:
+		return desc.ImportAction_IMPORT_ACTION_UPDATE
+	case model.ImportActionDisable:
+		return desc.ImportAction_IMPORT_ACTION_DISABLE
+	default:
+		return desc.ImportAction_IMPORT_ACTION_UNSPECIFIED
+	}
+}
+
+func importError(err error) error {
+	switch {
+	case errors.Is(err, provider.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, provider.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, provider.ErrDefaultRuleExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, provider.ErrInvalidImport), errors.Is(err, provider.ErrInvalidSchema),
+		errors.Is(err, provider.ErrInvalidVariantData), errors.Is(err, provider.ErrIncompatibleSchema),
+		errors.Is(err, provider.ErrUnknownBandit), errors.Is(err, provider.ErrInvalidBanditConfig):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, provider.ErrQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, provider.ErrApprovalRequired), errors.Is(err, provider.ErrDeprecatedBandit),
+		errors.Is(err, provider.ErrDestructiveForbidden), errors.Is(err, provider.ErrProtectionDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func templateError(err error) error {
+	switch {
+	case errors.Is(err, provider.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, provider.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, provider.ErrAlreadyExists), errors.Is(err, provider.ErrDefaultRuleExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, provider.ErrInvalidTemplate), errors.Is(err, provider.ErrInvalidSchema),
+		errors.Is(err, provider.ErrInvalidVariantData), errors.Is(err, provider.ErrUnknownBandit):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, provider.ErrQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, provider.ErrApprovalRequired), errors.Is(err, provider.ErrDeprecatedBandit):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func wantedBanditError(err error) error {
+	switch {
+	case errors.Is(err, provider.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, provider.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, provider.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, provider.ErrInvalidBanditConfig):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func encodeWantedBandit(wb *desc.WantedBandit) model.WantedBandit {
+	return model.WantedBandit{
+		BanditKey:     wb.GetBanditKey(),
+		Name:          wb.GetName(),
+		ConfigSchema:  wb.GetConfigSchema(),
+		DefaultConfig: wb.GetDefaultConfig(),
+	}
+}
+
+func decodeWantedBandit(wb model.WantedBandit) *desc.WantedBandit {
+	return &desc.WantedBandit{
+		BanditKey:         wb.BanditKey,
+		Name:              wb.Name,
+		ConfigSchema:      wb.ConfigSchema,
+		DefaultConfig:     wb.DefaultConfig,
+		Deprecated:        wb.Deprecated(),
+		DeprecationReason: wb.DeprecationReason,
+		DeprecatedAt:      decodeTimestamp(wb.DeprecatedAt),
+		CreatedAt:         decodeTimestamp(nonZeroTime(wb.CreatedAt)),
+		UpdatedAt:         decodeTimestamp(nonZeroTime(wb.UpdatedAt)),
+	}
+}
+
+func decodeBanditKeyIssue(v model.BanditKeyIssue) desc.BanditKeyIssue {
+	switch v {
+	case model.BanditKeyIssueDeprecated:
+		return desc.BanditKeyIssue_BANDIT_KEY_ISSUE_DEPRECATED
+	case model.BanditKeyIssueUnknown:
+		return desc.BanditKeyIssue_BANDIT_KEY_ISSUE_UNKNOWN
+	default:
+		return desc.BanditKeyIssue_BANDIT_KEY_ISSUE_UNSPECIFIED
+	}
+}
+
+func decodeLayer(l model.Layer) *desc.Layer {
+	return &desc.Layer{
+		Id:            l.Id,
+		Name:          l.Name,
+		Description:   l.Description,
+		ActiveRuleIds: l.ActiveRuleIds,
+	}
+}
+
+func decodeStateType(v model.StateType) desc.State {
+	switch v {
+	case model.StateTypeEnable:
+		return desc.State_STATE_ENABLED
+	case model.StateTypeDisable:
+		return desc.State_STATE_DISABLED
+	default:
+		return desc.State_STATE_UNSPECIFIED
+	}
+}
+
+func encodeStateType(v desc.State) model.StateType {
+	switch v {
+	case desc.State_STATE_ENABLED:
+		return model.StateTypeEnable
+	default:
+		return model.StateTypeDisable
+	}
+}
+
+func decodeVariants(in []model.Variant) []*desc.Variant {
+	out := make([]*desc.Variant, len(in))
+	for i, v := range in {
+		out[i] = decodeVariant(v)
+	}
+	return out
+}
+
+func decodeVariant(v model.Variant) *desc.Variant {
+	return &desc.Variant{
+		Id:      v.Id,
+		Name:    v.Name,
+		Data:    v.Data,
+		State:   decodeStateType(v.State),
+		StartAt: decodeTimestamp(v.StartAt),
+		EndAt:   decodeTimestamp(v.EndAt),
+	}
+}
+
+func encodeVariant(v *desc.Variant) model.Variant {
+	return model.Variant{
+		Id:      v.GetId(),
+		Name:    v.GetName(),
+		Data:    v.GetData(),
+		State:   encodeStateType(v.State),
+		StartAt: encodeTimestamp(v.GetStartAt()),
+		EndAt:   encodeTimestamp(v.GetEndAt()),
+	}
+}
+
+func encodeVariants(in []*desc.Variant) []model.Variant {
+	out := make([]model.Variant, len(in))
+	for i, v := range in {
+		out[i] = encodeVariant(v)
+	}
+	return out
+}
+
+func decodeVariantResponse(v model.Variant) *desc.VariantResponse {
+	return &desc.VariantResponse{
+		Variant: decodeVariant(v),
+	}
+}
+
+func encodeTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime().UTC()
+	return &t
+}
+
+func decodeTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func nonZeroTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func encodeRuleFilter(req *desc.ListRulesRequest) (model.RuleFilter, error) {
+	f := model.RuleFilter{
+		Service:     req.GetService(),
+		Context:     req.GetContext(),
+		BanditKey:   req.GetBanditKey(),
+		Name:        req.GetName(),
+		CreatedFrom: encodeTimestamp(req.GetCreatedFrom()),
+		CreatedTo:   encodeTimestamp(req.GetCreatedTo()),
+		UpdatedFrom: encodeTimestamp(req.GetUpdatedFrom()),
+		UpdatedTo:   encodeTimestamp(req.GetUpdatedTo()),
+		SortDesc:    req.GetSortDirection() == desc.SortDirection_SORT_DIRECTION_DESC,
+		Limit:       int(req.GetPageSize()),
+	}
+
+	if req.GetState() != desc.State_STATE_UNSPECIFIED {
+		f.State = encodeStateType(req.GetState())
+	}
+
+	switch req.GetSortField() {
+	case desc.RuleSortField_RULE_SORT_FIELD_UPDATED_AT:
+		f.SortField = model.RuleSortFieldUpdatedAt
+	case desc.RuleSortField_RULE_SORT_FIELD_NAME:
+		f.SortField = model.RuleSortFieldName
+	default:
+		f.SortField = model.RuleSortFieldCreatedAt
+	}
+
+	if f.Limit <= 0 {
+		f.Limit = defaultPageSize
+	}
+	if f.Limit > maxPageSize {
+		f.Limit = maxPageSize
+	}
+
+	if len(req.GetPageToken()) > 0 {
+		cursor, err := decodePageToken(req.GetPageToken())
+		if err != nil {
+			return model.RuleFilter{}, err
+		}
+		if cursor.Field != f.SortField {
+			return model.RuleFilter{}, errors.New("page token does not match sort field")
+		}
+		f.Cursor = &cursor
+	}
+
+	return f, nil
+}
+
+func encodePageToken(c model.RuleCursor) (string, error) {
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodePageToken(token string) (model.RuleCursor, error) {
+	var c model.RuleCursor
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, errors.New("invalid page token")
+	}
+	if err := json.Unmarshal(raw, &c); err != nil || len(c.Id) == 0 {
+		return c, errors.New("invalid page token")
+	}
+
+	return c, nil
+}
+
+func validateSchedule(startAt, endAt *time.Time) error {
+	if startAt != nil && endAt != nil && !endAt.After(*startAt) {
+		return status.Error(codes.InvalidArgument, "end_at must be after start_at")
+	}
+	return nil
+}
+
+func validateTargeting(in []*desc.TargetingCondition) error {
+	for _, c := range in {
+		if len(c.GetAttribute()) == 0 || len(c.GetValues()) == 0 {
+			return status.Error(codes.InvalidArgument, "empty targeting attribute or values")
+		}
+
+		switch c.GetOperator() {
+		case desc.TargetingOperator_TARGETING_OPERATOR_IN, desc.TargetingOperator_TARGETING_OPERATOR_NOT_IN:
+		case desc.TargetingOperator_TARGETING_OPERATOR_EQ,
+			desc.TargetingOperator_TARGETING_OPERATOR_VERSION_GTE,
+			desc.TargetingOperator_TARGETING_OPERATOR_VERSION_LT:
+			if len(c.GetValues()) != 1 {
+				return status.Errorf(codes.InvalidArgument, "operator %s expects exactly one value", c.GetOperator())
+			}
+		default:
+			return status.Error(codes.InvalidArgument, "unspecified targeting operator")
+		}
+	}
+	return nil
+}
+
+func encodeTargeting(in []*desc.TargetingCondition) []model.TargetingCondition {
+	out := make([]model.TargetingCondition, len(in))
+	for i, c := range in {
+		out[i] = model.TargetingCondition{
+			Attribute: c.GetAttribute(),
+			Operator:  encodeTargetingOperator(c.GetOperator()),
+			Values:    c.GetValues(),
+		}
+	}
+	return out
+}
+
+func decodeTargeting(in []model.TargetingCondition) []*desc.TargetingCondition {
+	out := make([]*desc.TargetingCondition, len(in))
+	for i, c := range in {
+		out[i] = &desc.TargetingCondition{
+			Attribute: c.Attribute,
+			Operator:  decodeTargetingOperator(c.Operator),
+			Values:    c.Values,
+		}
+	}
+	return out
+}
+
+func encodeTargetingOperator(v desc.TargetingOperator) model.TargetingOperator {
+	switch v {
+	case desc.TargetingOperator_TARGETING_OPERATOR_EQ:
+		return model.TargetingOperatorEq
+	case desc.TargetingOperator_TARGETING_OPERATOR_IN:
+		return model.TargetingOperatorIn
+	case desc.TargetingOperator_TARGETING_OPERATOR_NOT_IN:
+		return model.TargetingOperatorNotIn
+	case desc.TargetingOperator_TARGETING_OPERATOR_VERSION_GTE:
+		return model.TargetingOperatorVersionGte
+	case desc.TargetingOperator_TARGETING_OPERATOR_VERSION_LT:
+		return model.TargetingOperatorVersionLt
+	default:
+		return ""
+	}
+}
+
+func decodeTargetingOperator(v model.TargetingOperator) desc.TargetingOperator {
+	switch v {
+	case model.TargetingOperatorEq:
+		return desc.TargetingOperator_TARGETING_OPERATOR_EQ
+	case model.TargetingOperatorIn:
+		return desc.TargetingOperator_TARGETING_OPERATOR_IN
+	case model.TargetingOperatorNotIn:
+		return desc.TargetingOperator_TARGETING_OPERATOR_NOT_IN
+	case model.TargetingOperatorVersionGte:
+		return desc.TargetingOperator_TARGETING_OPERATOR_VERSION_GTE
+	case model.TargetingOperatorVersionLt:
+		return desc.TargetingOperator_TARGETING_OPERATOR_VERSION_LT
+	default:
+		return desc.TargetingOperator_TARGETING_OPERATOR_UNSPECIFIED
+	}
+}
+
+func encodeGuardrail(g *desc.Guardrail) model.Guardrail {
+	return model.Guardrail{
+		Id:        g.GetId(),
+		RuleId:    g.GetRuleId(),
+		Metric:    encodeGuardrailMetric(g.GetMetric()),
+		Threshold: g.GetThreshold(),
+		WindowSec: int64(g.GetWindow().AsDuration().Seconds()),
+		MinCount:  g.GetMinCount(),
+	}
+}
+
+func decodeGuardrail(g model.Guardrail) *desc.Guardrail {
+	return &desc.Guardrail{
+		Id:        g.Id,
+		RuleId:    g.RuleId,
+		Metric:    decodeGuardrailMetric(g.Metric),
+		Threshold: g.Threshold,
+		Window:    durationpb.New(time.Duration(g.WindowSec) * time.Second),
+		MinCount:  g.MinCount,
+	}
+}
+
+func decodeGuardrails(in []model.Guardrail) []*desc.Guardrail {
+	out := make([]*desc.Guardrail, len(in))
+	for i, g := range in {
+		out[i] = decodeGuardrail(g)
+	}
+	return out
+}
+
+func encodeGuardrailMetric(v desc.GuardrailMetric) model.GuardrailMetric {
+	switch v {
+	case desc.GuardrailMetric_GUARDRAIL_METRIC_ERROR_RATE:
+		return model.GuardrailMetricErrorRate
+	case desc.GuardrailMetric_GUARDRAIL_METRIC_MIN_REWARD:
+		return model.GuardrailMetricMinReward
+	default:
+		return ""
+	}
+}
+
+func decodeGuardrailMetric(v model.GuardrailMetric) desc.GuardrailMetric {
+	switch v {
+	case model.GuardrailMetricErrorRate:
+		return desc.GuardrailMetric_GUARDRAIL_METRIC_ERROR_RATE
+	case model.GuardrailMetricMinReward:
+		return desc.GuardrailMetric_GUARDRAIL_METRIC_MIN_REWARD
+	default:
+		return desc.GuardrailMetric_GUARDRAIL_METRIC_UNSPECIFIED
 	}
 }
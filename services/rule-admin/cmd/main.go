@@ -1,9 +1,29 @@
 package main
 
 import (
+	"os"
+
+	"github.com/EbumbaE/bandit/services/rule-admin/cmd/rules"
 	"github.com/EbumbaE/bandit/services/rule-admin/cmd/run"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "export":
+			rules.Export(os.Args[2:])
+			return
+		case "import":
+			rules.Import(os.Args[2:])
+			return
+		case "reconcile":
+			rules.Reconcile(os.Args[2:])
+			return
+		case "migrate":
+			run.Migrate(os.Args[2:])
+			return
+		}
+	}
+
 	run.Run()
 }
@@ -0,0 +1,224 @@
+package rules
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/EbumbaE/bandit/pkg/auth"
+	desc "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/metrics"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/reconciler"
+)
+
+const apiKeyEnv = "RULE_ADMIN_API_KEY"
+
+type connFlags struct {
+	addr    *string
+	apiKey  *string
+	timeout *time.Duration
+}
+
+func registerConnFlags(fs *flag.FlagSet) connFlags {
+	return connFlags{
+		addr:    fs.String("addr", "localhost:8444", "rule-admin grpc address"),
+		apiKey:  fs.String("api-key", os.Getenv(apiKeyEnv), "api key, defaults to $"+apiKeyEnv),
+		timeout: fs.Duration("timeout", 30*time.Second, "request timeout"),
+	}
+}
+
+func (f connFlags) client(ctx context.Context) (desc.RuleAdminServiceClient, func(), error) {
+	conn, err := grpc.DialContext(ctx, *f.addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(auth.NewAPIKeyCredentials(*f.apiKey)),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return desc.NewRuleAdminServiceClient(conn), func() { _ = conn.Close() }, nil
+}
+
+// Export writes rules of rule-admin as a document to a file or stdout.
+func Export(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	conn := registerConnFlags(fs)
+	service := fs.String("service", "", "export only rules of the service")
+	ruleContext := fs.String("context", "", "export only rules of the context")
+	format := fs.String("format", "", "yaml or json, detected by -out extension when empty")
+	out := fs.String("out", "", "output file, stdout when empty")
+	_ = fs.Parse(args)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *conn.timeout)
+	defer cancel()
+
+	cl, closeConn, err := conn.client(ctx)
+	if err != nil {
+		log.Fatal("connect to rule-admin: ", err)
+	}
+	defer closeConn()
+
+	resp, err := cl.ExportRules(ctx, &desc.ExportRulesRequest{
+		Service: *service,
+		Context: *ruleContext,
+		Format:  documentFormat(*format, *out),
+	})
+	if err != nil {
+		log.Fatal("export rules: ", err)
+	}
+
+	if len(*out) == 0 {
+		fmt.Print(resp.GetDocument())
+		return
+	}
+
+	if err := os.WriteFile(*out, []byte(resp.GetDocument()), 0o644); err != nil {
+		log.Fatal("write document: ", err)
+	}
+}
+
+// Import applies a document to rule-admin and prints the plan of changes.
+func Import(args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	conn := registerConnFlags(fs)
+	file := fs.String("file", "", "document file, stdin when empty or -")
+	format := fs.String("format", "", "yaml or json, detected by -file extension when empty")
+	dryRun := fs.Bool("dry-run", false, "only print the plan")
+	prune := fs.Bool("prune", false, "disable rules of the document services missing in the document")
+	_ = fs.Parse(args)
+
+	raw, err := readDocument(*file)
+	if err != nil {
+		log.Fatal("read document: ", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *conn.timeout)
+	defer cancel()
+
+	cl, closeConn, err := conn.client(ctx)
+	if err != nil {
+		log.Fatal("connect to rule-admin: ", err)
+	}
+	defer closeConn()
+
+	resp, err := cl.ImportRules(ctx, &desc.ImportRulesRequest{
+		Document: string(raw),
+		Format:   documentFormat(*format, *file),
+		DryRun:   *dryRun,
+		Prune:    *prune,
+	})
+	if err != nil {
+		log.Fatal("import rules: ", err)
+	}
+
+	printPlan(os.Stdout, resp)
+}
+
+// Reconcile keeps rule-admin converged to the manifests of a directory until interrupted.
+func Reconcile(args []string) {
+	fs := flag.NewFlagSet("reconcile", flag.ExitOnError)
+	conn := registerConnFlags(fs)
+	dir := fs.String("dir", "", "directory with rule manifests")
+	interval := fs.Duration("interval", time.Minute, "reconcile interval")
+	dryRun := fs.Bool("dry-run", false, "only report drift")
+	once := fs.Bool("once", false, "reconcile once and exit")
+	metricsAddr := fs.String("metrics-addr", ":9464", "metrics listen address, disabled when empty")
+	_ = fs.Parse(args)
+
+	if len(*dir) == 0 {
+		log.Fatal("reconcile: -dir is required")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	cl, closeConn, err := conn.client(ctx)
+	if err != nil {
+		log.Fatal("connect to rule-admin: ", err)
+	}
+	defer closeConn()
+
+	r := reconciler.New(timeoutImporter{cl: cl, timeout: *conn.timeout}, *dir, *interval, *dryRun)
+
+	if *once {
+		if err := r.Reconcile(ctx); err != nil {
+			log.Fatal("reconcile: ", err)
+		}
+		return
+	}
+
+	if len(*metricsAddr) > 0 {
+		metrics.StartMetricsServer(ctx, *metricsAddr)
+		defer metrics.StopMetricsServer(context.Background())
+	}
+
+	r.Run(ctx)
+}
+
+type timeoutImporter struct {
+	cl      desc.RuleAdminServiceClient
+	timeout time.Duration
+}
+
+func (i timeoutImporter) ImportRules(ctx context.Context, req *desc.ImportRulesRequest, opts ...grpc.CallOption) (*desc.ImportRulesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	return i.cl.ImportRules(ctx, req, opts...)
+}
+
+func readDocument(file string) ([]byte, error) {
+	if len(file) == 0 || file == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(file)
+}
+
+func documentFormat(format, file string) desc.DocumentFormat {
+	if len(format) == 0 {
+		format = strings.TrimPrefix(filepath.Ext(file), ".")
+	}
+
+	switch strings.ToLower(format) {
+	case "json":
+		return desc.DocumentFormat_DOCUMENT_FORMAT_JSON
+	default:
+		return desc.DocumentFormat_DOCUMENT_FORMAT_YAML
+	}
+}
+
+func printPlan(w io.Writer, resp *desc.ImportRulesResponse) {
+	if len(resp.GetChanges()) == 0 {
+		fmt.Fprintln(w, "no changes")
+		return
+	}
+
+	for _, c := range resp.GetChanges() {
+		action := strings.ToLower(strings.TrimPrefix(c.GetAction().String(), "IMPORT_ACTION_"))
+		fmt.Fprintf(w, "%-8s %s/%s/%s", action, c.GetService(), c.GetContext(), c.GetName())
+		if len(c.GetRuleId()) > 0 {
+			fmt.Fprintf(w, " [%s]", c.GetRuleId())
+		}
+		fmt.Fprintln(w)
+		for _, d := range c.GetDetails() {
+			fmt.Fprintf(w, "    %s\n", d)
+		}
+	}
+
+	if resp.GetApplied() {
+		fmt.Fprintf(w, "applied %d changes\n", len(resp.GetChanges()))
+	} else {
+		fmt.Fprintf(w, "plan: %d changes, run without -dry-run to apply\n", len(resp.GetChanges()))
+	}
+}
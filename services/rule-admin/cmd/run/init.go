@@ -4,10 +4,12 @@ import (
 	"context"
 	"sync"
 
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/EbumbaE/bandit/pkg/auth"
 	rule_diller_client "github.com/EbumbaE/bandit/pkg/genproto/rule-diller/api"
 	"github.com/EbumbaE/bandit/pkg/kafka"
 	"github.com/EbumbaE/bandit/pkg/logger"
@@ -17,6 +19,7 @@ import (
 	rule_diller_wrapper "github.com/EbumbaE/bandit/services/rule-admin/internal/client"
 	"github.com/EbumbaE/bandit/services/rule-admin/internal/notifier"
 	"github.com/EbumbaE/bandit/services/rule-admin/internal/provider"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/scheduler"
 	rule_admin_storage "github.com/EbumbaE/bandit/services/rule-admin/internal/storage"
 	"github.com/EbumbaE/bandit/services/rule-admin/server"
 )
@@ -48,6 +51,8 @@ type application struct {
 	connections  connections
 	repositories repositories
 	provider     *provider.Provider
+	scheduler    *scheduler.Scheduler
+	relay        *notifier.Relay
 	service      *rule_admin_service.Implementation
 
 	cfg Config
@@ -64,7 +69,9 @@ func newApp(ctx context.Context, cfg *Config) *application {
 	a.initClients(ctx)
 	a.initConnections(ctx)
 	a.initRepos(ctx)
+	a.initNotifiers()
 	a.initProvider()
+	a.initScheduler()
 	a.initService()
 
 	return &a
@@ -77,7 +84,6 @@ func (a *application) initProducers(ctx context.Context) {
 	}
 
 	a.producers.ruleAdmin = producer
-	a.notifiers.ruleAdmin = notifier.NewNotifier(producer)
 }
 
 func (a *application) initClients(ctx context.Context) {
@@ -108,8 +114,17 @@ func (a *application) initRepos(ctx context.Context) {
 	}
 }
 
+func (a *application) initNotifiers() {
+	a.notifiers.ruleAdmin = notifier.NewNotifier(a.repositories.ruleAdmin)
+	a.relay = notifier.NewRelay(a.repositories.ruleAdmin, a.producers.ruleAdmin, a.cfg.Outbox.Interval, a.cfg.Outbox.BatchSize)
+}
+
 func (a *application) initProvider() {
-	a.provider = provider.NewProvider(a.repositories.ruleAdmin, a.notifiers.ruleAdmin)
+	a.provider = provider.NewProvider(a.repositories.ruleAdmin, a.notifiers.ruleAdmin, a.cfg.Auth.Enabled)
+}
+
+func (a *application) initScheduler() {
+	a.scheduler = scheduler.NewScheduler(a.repositories.ruleAdmin, a.provider, a.cfg.Scheduler.Interval)
 }
 
 func (a *application) initService() {
@@ -117,12 +132,48 @@ func (a *application) initService() {
 }
 
 func (a *application) Run(ctx context.Context, swaggerPath string) error {
-	server.StartRuleAdmin(ctx, a.service, a.wg, a.cfg.Service.GrpcAddress)
+	interceptors := make([]grpc.UnaryServerInterceptor, 0, 2)
+	if a.cfg.Auth.Enabled {
+		authn, err := a.newAuthenticator()
+		if err != nil {
+			return err
+		}
+		interceptors = append(interceptors, auth.UnaryServerInterceptor(authn, rule_admin_service.MethodRoles))
+	}
+	interceptors = append(interceptors, a.service.AuditInterceptor())
+
+	server.StartRuleAdmin(ctx, a.service, a.wg, a.cfg.Service.GrpcAddress, grpc.ChainUnaryInterceptor(interceptors...))
 	server.InitRuleAdminSwagger(ctx, a.wg, swaggerPath, a.cfg.Service.SwaggerAddress, a.cfg.Service.SwaggerHost, a.cfg.Service.GrpcAddress)
 
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		a.scheduler.Run(ctx)
+	}()
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		a.relay.Run(ctx)
+	}()
+
 	return nil
 }
 
+func (a *application) newAuthenticator() (auth.Authenticator, error) {
+	chain := auth.Chain{auth.NewAPIKeyAuthenticator(a.cfg.Auth.APIKeys)}
+
+	if a.cfg.Auth.JWT != nil {
+		jwtAuthn, err := auth.NewJWTAuthenticator(*a.cfg.Auth.JWT)
+		if err != nil {
+			return nil, errors.Wrap(err, "init jwt authenticator")
+		}
+		chain = append(chain, jwtAuthn)
+	}
+
+	return chain, nil
+}
+
 func (a *application) Close() {
 	a.connections.db.Close()
 	a.producers.ruleAdmin.Close()
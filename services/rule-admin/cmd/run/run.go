@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v2"
 
+	"github.com/EbumbaE/bandit/pkg/auth"
 	"github.com/EbumbaE/bandit/pkg/logger"
 )
 
@@ -59,9 +60,12 @@ func readConfig(configPath string) *Config {
 }
 
 type Config struct {
-	Service  RuleAdminService `yaml:"service"`
-	Postgres Postgres         `yaml:"postgres"`
-	Kafka    Kafka            `yaml:"kafka"`
+	Service   RuleAdminService `yaml:"service"`
+	Postgres  Postgres         `yaml:"postgres"`
+	Kafka     Kafka            `yaml:"kafka"`
+	Scheduler Scheduler        `yaml:"scheduler"`
+	Outbox    Outbox           `yaml:"outbox"`
+	Auth      Auth             `yaml:"auth"`
 }
 
 type RuleAdminService struct {
@@ -80,3 +84,18 @@ type Kafka struct {
 	Brokers []string `yaml:"brokers"`
 	Topic   string   `yaml:"topic"`
 }
+
+type Scheduler struct {
+	Interval time.Duration `yaml:"interval"`
+}
+
+type Outbox struct {
+	Interval  time.Duration `yaml:"interval"`
+	BatchSize int           `yaml:"batch_size"`
+}
+
+type Auth struct {
+	Enabled bool            `yaml:"enabled"`
+	APIKeys []auth.APIKey   `yaml:"api_keys"`
+	JWT     *auth.JWTConfig `yaml:"jwt"`
+}
@@ -0,0 +1,243 @@
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+)
+
+// Version is the current document format version, bumped on incompatible changes.
+const Version = 1
+
+// Annotations are read by the reconciler, rule-admin checks bandit.io/allow-destructive
+// only on imports with guard_destructive.
+const (
+	// AnnotationAllowDestructive on a rule allows disabling the rule or its variants.
+	AnnotationAllowDestructive = "bandit.io/allow-destructive"
+	// AnnotationPrune on a document allows disabling rules of its services missing in manifests.
+	AnnotationPrune = "bandit.io/prune"
+)
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported document version")
+	ErrUnsupportedFormat  = errors.New("unsupported document format")
+)
+
+type Format string
+
+var (
+	FormatYAML Format = "yaml"
+	FormatJSON Format = "json"
+)
+
+type Document struct {
+	Version     int               `yaml:"version" json:"version"`
+	Annotations map[string]string `yaml:"annotations,omitempty" json:"annotations,omitempty"`
+	Rules       []Rule            `yaml:"rules" json:"rules"`
+}
+
+// Rule is identified by service, context and name, so documents don't depend on
+// ids of a particular installation.
+type Rule struct {
+	Service     string `yaml:"service" json:"service"`
+	Context     string `yaml:"context" json:"context"`
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	BanditKey   string `yaml:"bandit_key" json:"bandit_key"`
+	// BanditConfig defaults to the default config of the bandit key.
+	BanditConfig  string      `yaml:"bandit_config,omitempty" json:"bandit_config,omitempty"`
+	State         string      `yaml:"state" json:"state"`
+	Priority      int32       `yaml:"priority,omitempty" json:"priority,omitempty"`
+	Targeting     []Condition `yaml:"targeting,omitempty" json:"targeting,omitempty"`
+	VariantSchema string      `yaml:"variant_schema,omitempty" json:"variant_schema,omitempty"`
+	Protected     bool        `yaml:"protected,omitempty" json:"protected,omitempty"`
+	// Layer is the layer name, it must be unique among layers visible to the importer.
+	Layer    string     `yaml:"layer,omitempty" json:"layer,omitempty"`
+	StartAt  *time.Time `yaml:"start_at,omitempty" json:"start_at,omitempty"`
+	EndAt    *time.Time `yaml:"end_at,omitempty" json:"end_at,omitempty"`
+	Variants []Variant  `yaml:"variants" json:"variants"`
+
+	Annotations map[string]string `yaml:"annotations,omitempty" json:"annotations,omitempty"`
+}
+
+type Condition struct {
+	Attribute string   `yaml:"attribute" json:"attribute"`
+	Operator  string   `yaml:"operator" json:"operator"`
+	Values    []string `yaml:"values" json:"values"`
+}
+
+type Variant struct {
+	Name    string     `yaml:"name" json:"name"`
+	Data    string     `yaml:"data" json:"data"`
+	State   string     `yaml:"state" json:"state"`
+	StartAt *time.Time `yaml:"start_at,omitempty" json:"start_at,omitempty"`
+	EndAt   *time.Time `yaml:"end_at,omitempty" json:"end_at,omitempty"`
+}
+
+func New(rules []model.Rule) Document {
+	doc := Document{Version: Version, Rules: make([]Rule, 0, len(rules))}
+	for _, r := range rules {
+		doc.Rules = append(doc.Rules, fromRule(r))
+	}
+
+	sort.Slice(doc.Rules, func(i, j int) bool {
+		a, b := doc.Rules[i], doc.Rules[j]
+		if a.Service != b.Service {
+			return a.Service < b.Service
+		}
+		if a.Context != b.Context {
+			return a.Context < b.Context
+		}
+		return a.Name < b.Name
+	})
+
+	return doc
+}
+
+func Encode(doc Document, format Format) ([]byte, error) {
+	switch format {
+	case FormatYAML, "":
+		return yaml.Marshal(doc)
+	case FormatJSON:
+		return json.MarshalIndent(doc, "", "  ")
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+}
+
+func Decode(raw []byte, format Format) (Document, error) {
+	var (
+		doc Document
+		err error
+	)
+
+	switch format {
+	case FormatYAML, "":
+		err = yaml.UnmarshalStrict(raw, &doc)
+	case FormatJSON:
+		err = json.Unmarshal(raw, &doc)
+	default:
+		return Document{}, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+	if err != nil {
+		return Document{}, errors.Wrap(err, "unmarshal document")
+	}
+
+	if doc.Version != Version {
+		return Document{}, fmt.Errorf("%w: %d", ErrUnsupportedVersion, doc.Version)
+	}
+
+	return doc, nil
+}
+
+func (d Document) ModelRules() []model.Rule {
+	rules := make([]model.Rule, 0, len(d.Rules))
+	for _, r := range d.Rules {
+		rules = append(rules, r.toModel())
+	}
+	return rules
+}
+
+// AllowedDestructive returns service/context/name of rules annotated with
+// bandit.io/allow-destructive.
+func (d Document) AllowedDestructive() map[string]bool {
+	res := make(map[string]bool)
+	for _, r := range d.Rules {
+		if r.Annotations[AnnotationAllowDestructive] == "true" {
+			res[r.Service+"/"+r.Context+"/"+r.Name] = true
+		}
+	}
+	return res
+}
+
+func fromRule(r model.Rule) Rule {
+	res := Rule{
+		Service:       r.Service,
+		Context:       r.Context,
+		Name:          r.Name,
+		Description:   r.Description,
+		BanditKey:     r.BanditKey,
+		BanditConfig:  r.BanditConfig,
+		State:         string(r.State),
+		Priority:      r.Priority,
+		VariantSchema: r.VariantSchema,
+		Protected:     r.Protected,
+		Layer:         r.LayerName,
+		StartAt:       r.StartAt,
+		EndAt:         r.EndAt,
+		Variants:      make([]Variant, 0, len(r.Variants)),
+	}
+
+	for _, c := range r.Targeting {
+		res.Targeting = append(res.Targeting, Condition{
+			Attribute: c.Attribute,
+			Operator:  string(c.Operator),
+			Values:    c.Values,
+		})
+	}
+
+	for _, v := range r.Variants {
+		res.Variants = append(res.Variants, Variant{
+			Name:    v.Name,
+			Data:    v.Data,
+			State:   string(v.State),
+			StartAt: v.StartAt,
+			EndAt:   v.EndAt,
+		})
+	}
+	sort.Slice(res.Variants, func(i, j int) bool { return res.Variants[i].Name < res.Variants[j].Name })
+
+	return res
+}
+
+func (r Rule) toModel() model.Rule {
+	res := model.Rule{
+		Service:       r.Service,
+		Context:       r.Context,
+		Name:          r.Name,
+		Description:   r.Description,
+		BanditKey:     r.BanditKey,
+		BanditConfig:  r.BanditConfig,
+		State:         stateOrDefault(r.State),
+		Priority:      r.Priority,
+		Targeting:     []model.TargetingCondition{},
+		VariantSchema: r.VariantSchema,
+		Protected:     r.Protected,
+		LayerName:     r.Layer,
+		StartAt:       r.StartAt,
+		EndAt:         r.EndAt,
+	}
+
+	for _, c := range r.Targeting {
+		res.Targeting = append(res.Targeting, model.TargetingCondition{
+			Attribute: c.Attribute,
+			Operator:  model.TargetingOperator(c.Operator),
+			Values:    c.Values,
+		})
+	}
+
+	for _, v := range r.Variants {
+		res.Variants = append(res.Variants, model.Variant{
+			Name:    v.Name,
+			Data:    v.Data,
+			State:   stateOrDefault(v.State),
+			StartAt: v.StartAt,
+			EndAt:   v.EndAt,
+		})
+	}
+
+	return res
+}
+
+func stateOrDefault(state string) model.StateType {
+	if len(state) == 0 {
+		return model.StateTypeEnable
+	}
+	return model.StateType(state)
+}
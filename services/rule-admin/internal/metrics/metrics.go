@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	ReconcileRuns    *prometheus.CounterVec
+	ReconcileDrift   *prometheus.GaugeVec
+	ReconcileRefused *prometheus.GaugeVec
+	ReconcileApplied *prometheus.CounterVec
+)
+
+func init() {
+	ReconcileRuns = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "rule_admin",
+			Name:      "reconcile_runs_total",
+			Help:      "Reconcile runs of a service by status",
+		},
+		[]string{"service", "status"},
+	)
+	ReconcileDrift = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "rule_admin",
+			Name:      "reconcile_drift",
+			Help:      "Rules differing from manifests on the last reconcile run",
+		},
+		[]string{"service", "action"},
+	)
+	ReconcileRefused = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "rule_admin",
+			Name:      "reconcile_refused",
+			Help:      "Destructive changes refused on the last reconcile run for lack of annotation",
+		},
+		[]string{"service"},
+	)
+	ReconcileApplied = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "rule_admin",
+			Name:      "reconcile_applied_total",
+			Help:      "Changes applied by the reconciler",
+		},
+		[]string{"service", "action"},
+	)
+}
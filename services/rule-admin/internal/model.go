@@ -1,15 +1,87 @@
 package internal
 
+import "time"
+
 type Rule struct {
-	Id          string    `db:"id"`
-	Name        string    `db:"name"`
-	Description string    `db:"description"`
-	State       StateType `db:"state"`
-	BanditKey   string    `db:"bandit_key"`
-	Service     string    `db:"service"`
-	Context     string    `db:"context"`
+	Id            string               `db:"id" json:"id"`
+	Name          string               `db:"name" json:"name"`
+	Description   string               `db:"description" json:"description"`
+	State         StateType            `db:"state" json:"state"`
+	BanditKey     string               `db:"bandit_key" json:"bandit_key"`
+	Service       string               `db:"service" json:"service"`
+	Context       string               `db:"context" json:"context"`
+	LayerId       string               `db:"layer_id" json:"layer_id"`
+	StartAt       *time.Time           `db:"start_at" json:"start_at"`
+	EndAt         *time.Time           `db:"end_at" json:"end_at"`
+	Priority      int32                `db:"priority" json:"priority"`
+	Targeting     []TargetingCondition `db:"targeting" json:"targeting"`
+	VariantSchema string               `db:"variant_schema" json:"variant_schema"`
+	Protected     bool                 `db:"protected" json:"protected"`
+	BanditConfig  string               `db:"bandit_config" json:"bandit_config"`
+	CreatedAt     time.Time            `db:"created_at" json:"created_at"`
+	UpdatedAt     time.Time            `db:"updated_at" json:"updated_at"`
+
+	Variants []Variant `json:"variants"`
+
+	// LayerName identifies the layer in bundle documents, ids differ between installations.
+	LayerName string `db:"-" json:"-"`
+}
+
+type RuleSortField string
+
+var (
+	RuleSortFieldCreatedAt RuleSortField = "created_at"
+	RuleSortFieldUpdatedAt RuleSortField = "updated_at"
+	RuleSortFieldName      RuleSortField = "name"
+)
+
+type RuleFilter struct {
+	Service     string
+	Services    []string
+	Context     string
+	State       StateType
+	BanditKey   string
+	Name        string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+
+	SortField RuleSortField
+	SortDesc  bool
+	Limit     int
+	Cursor    *RuleCursor
+}
+
+type RuleCursor struct {
+	Field RuleSortField `json:"f"`
+	Value string        `json:"v"`
+	Id    string        `json:"id"`
+}
+
+type TargetingOperator string
+
+var (
+	TargetingOperatorEq         TargetingOperator = "eq"
+	TargetingOperatorIn         TargetingOperator = "in"
+	TargetingOperatorNotIn      TargetingOperator = "not_in"
+	TargetingOperatorVersionGte TargetingOperator = "version_gte"
+	TargetingOperatorVersionLt  TargetingOperator = "version_lt"
+)
+
+type TargetingCondition struct {
+	Attribute string            `json:"attribute"`
+	Operator  TargetingOperator `json:"operator"`
+	Values    []string          `json:"values"`
+}
+
+type Layer struct {
+	Id          string `db:"id"`
+	Name        string `db:"name"`
+	Description string `db:"description"`
+	Tenant      string `db:"tenant"`
 
-	Variants []Variant
+	ActiveRuleIds []string
 }
 
 type StateType string
@@ -20,13 +92,229 @@ var (
 )
 
 type Variant struct {
-	Id    string    `db:"id"`
-	Name  string    `db:"name"`
-	Data  string    `db:"data"`
-	State StateType `db:"state"`
+	Id      string     `db:"id" json:"id"`
+	RuleId  string     `db:"rule_id" json:"rule_id"`
+	Name    string     `db:"name" json:"name"`
+	Data    string     `db:"data" json:"data"`
+	State   StateType  `db:"state" json:"state"`
+	StartAt *time.Time `db:"start_at" json:"start_at"`
+	EndAt   *time.Time `db:"end_at" json:"end_at"`
+
+	CloneOf string `db:"-" json:"clone_of,omitempty"`
+}
+
+type RuleRevision struct {
+	Id        string    `db:"id"`
+	RuleId    string    `db:"rule_id"`
+	Revision  uint64    `db:"revision"`
+	Reason    string    `db:"reason"`
+	Snapshot  []byte    `db:"snapshot"`
+	CreatedAt time.Time `db:"created_at"`
+
+	Rule Rule `db:"-"`
+}
+
+type RevisionChange struct {
+	Path string
+	From string
+	To   string
+}
+
+type AuditEvent struct {
+	Id        string    `db:"id"`
+	Actor     string    `db:"actor"`
+	Method    string    `db:"method"`
+	RuleId    string    `db:"rule_id"`
+	Request   string    `db:"request"`
+	PrevState string    `db:"prev_state"`
+	NewState  string    `db:"new_state"`
+	Error     string    `db:"error"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type AuditFilter struct {
+	RuleId   string
+	Actor    string
+	Services []string
+	From     *time.Time
+	To       *time.Time
+	Limit    int
+}
+
+type Tenant struct {
+	Name        string   `db:"name"`
+	Description string   `db:"description"`
+	MaxRules    int      `db:"max_rules"`
+	MaxVariants int      `db:"max_variants"`
+	Services    []string `db:"services"`
+
+	RuleCount    int `db:"-"`
+	VariantCount int `db:"-"`
+}
+
+type ChangeOperation string
+
+var (
+	ChangeOperationCreateRule    ChangeOperation = "create_rule"
+	ChangeOperationAddVariant    ChangeOperation = "add_variant"
+	ChangeOperationSetRuleState  ChangeOperation = "set_rule_state"
+	ChangeOperationMigrateBandit ChangeOperation = "migrate_bandit"
+	ChangeOperationResetStats    ChangeOperation = "reset_stats"
+	ChangeOperationRollbackRule  ChangeOperation = "rollback_rule"
+	ChangeOperationImportRule    ChangeOperation = "import_rule"
+)
+
+type ChangeStatus string
+
+var (
+	ChangeStatusDraft         ChangeStatus = "draft"
+	ChangeStatusPendingReview ChangeStatus = "pending_review"
+	ChangeStatusApproved      ChangeStatus = "approved"
+	ChangeStatusApplied       ChangeStatus = "applied"
+	ChangeStatusRejected      ChangeStatus = "rejected"
+)
+
+type ChangeRequest struct {
+	Id        string          `db:"id"`
+	Operation ChangeOperation `db:"operation"`
+	RuleId    string          `db:"rule_id"`
+	Service   string          `db:"service"`
+	Payload   []byte          `db:"payload"`
+	Status    ChangeStatus    `db:"status"`
+	Author    string          `db:"author"`
+	Reviewer  string          `db:"reviewer"`
+	Comment   string          `db:"comment"`
+	CreatedAt time.Time       `db:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at"`
+}
+
+type ChangeRequestFilter struct {
+	RuleId   string
+	Status   ChangeStatus
+	Services []string
+	Limit    int
+}
+
+type CloneTarget struct {
+	Service         string
+	Context         string
+	Name            string
+	State           StateType
+	CarryStatistics bool
+}
+
+type RuleTemplate struct {
+	Name          string               `db:"name"`
+	Description   string               `db:"description"`
+	BanditKey     string               `db:"bandit_key"`
+	VariantSchema string               `db:"variant_schema"`
+	Priority      int32                `db:"priority"`
+	Targeting     []TargetingCondition `db:"targeting"`
+	Variants      []TemplateVariant    `db:"variants"`
+	Tenant        string               `db:"tenant"`
+	CreatedAt     time.Time            `db:"created_at"`
+	UpdatedAt     time.Time            `db:"updated_at"`
+
+	Parameters []string `db:"-"`
+}
+
+type TemplateVariant struct {
+	Name string `json:"name"`
+	Data string `json:"data"`
+}
+
+type TemplateInstance struct {
+	Service     string
+	Context     string
+	Name        string
+	Description string
+	State       StateType
+	Params      map[string]string
+}
+
+type OutboxEvent struct {
+	Id        int64     `db:"id"`
+	Key       string    `db:"key"`
+	Payload   []byte    `db:"payload"`
+	Attempts  int       `db:"attempts"`
+	LastError string    `db:"last_error"`
+	CreatedAt time.Time `db:"created_at"`
 }
 
 type WantedBandit struct {
-	BanditKey string `db:"bandit_key"`
-	Name      string `db:"name"`
+	BanditKey         string     `db:"bandit_key"`
+	Name              string     `db:"name"`
+	ConfigSchema      string     `db:"config_schema"`
+	DefaultConfig     string     `db:"default_config"`
+	DeprecatedAt      *time.Time `db:"deprecated_at"`
+	DeprecationReason string     `db:"deprecation_reason"`
+	CreatedAt         time.Time  `db:"created_at"`
+	UpdatedAt         time.Time  `db:"updated_at"`
+}
+
+func (wb WantedBandit) Deprecated() bool {
+	return wb.DeprecatedAt != nil
+}
+
+type BanditKeyIssue string
+
+var (
+	BanditKeyIssueDeprecated BanditKeyIssue = "deprecated"
+	BanditKeyIssueUnknown    BanditKeyIssue = "unknown"
+)
+
+// StaleBanditRule is a rule whose bandit key can't be used for new rules anymore.
+type StaleBanditRule struct {
+	Rule  Rule
+	Issue BanditKeyIssue
+}
+
+type GuardrailMetric string
+
+var (
+	GuardrailMetricErrorRate GuardrailMetric = "error_rate"
+	GuardrailMetricMinReward GuardrailMetric = "min_reward"
+)
+
+type Guardrail struct {
+	Id        string          `db:"id"`
+	RuleId    string          `db:"rule_id"`
+	Metric    GuardrailMetric `db:"metric"`
+	Threshold float64         `db:"threshold"`
+	WindowSec int64           `db:"window_sec"`
+	MinCount  uint64          `db:"min_count"`
+}
+
+type ImportAction string
+
+var (
+	ImportActionCreate  ImportAction = "create"
+	ImportActionUpdate  ImportAction = "update"
+	ImportActionDisable ImportAction = "disable"
+)
+
+type ImportOptions struct {
+	DryRun bool
+	Prune  bool
+	// GuardDestructive rejects destructive changes of rules missing in AllowDestructive,
+	// rules disabled by pruning are allowed by Prune.
+	GuardDestructive bool
+	// AllowDestructive holds service/context/name of rules allowed destructive changes.
+	AllowDestructive map[string]bool
+}
+
+type ImportChange struct {
+	Action  ImportAction
+	RuleId  string
+	Service string
+	Context string
+	Name    string
+	Details []string
+	Pruned  bool
+	// Destructive changes disable a rule or some of its variants.
+	Destructive bool
+	// Protected changes are not applied by the import, a change request is created instead.
+	Protected bool
+
+	Rule Rule
 }
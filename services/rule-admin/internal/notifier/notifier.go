@@ -14,22 +14,27 @@ var (
 	ActionDelete   ActionType = "delete"
 	ActionActive   ActionType = "active"
 	ActionInactive ActionType = "inactive"
+	ActionUpdate   ActionType = "update"
+	ActionMigrate  ActionType = "migrate"
+	ActionReset    ActionType = "reset"
 )
 
 func (a ActionType) String() string {
 	return string(a)
 }
 
-type Producer interface {
-	SendMessage(ctx context.Context, key, value []byte) error
+type Outbox interface {
+	AddOutboxEvent(ctx context.Context, key string, payload []byte) error
 }
 
+// Notifier stores events in the outbox; pass ctx carrying the transaction of the
+// change so the event is committed together with it. Relay publishes them to kafka.
 type Notifier struct {
-	producer Producer
+	outbox Outbox
 }
 
-func NewNotifier(producer Producer) *Notifier {
-	return &Notifier{producer: producer}
+func NewNotifier(outbox Outbox) *Notifier {
+	return &Notifier{outbox: outbox}
 }
 
 type Event struct {
@@ -37,28 +42,35 @@ type Event struct {
 	Action    string `json:"action"`
 	RuleID    string `json:"rule_id"`
 	VariantID string `json:"variant_id"`
+
+	SourceVariantID string `json:"source_variant_id,omitempty"`
 }
 
 func (n *Notifier) SendRule(ctx context.Context, ruleID string, action ActionType) error {
-	return n.send(ctx, ruleID, "", "rule", action)
+	return n.send(ctx, Event{Type: "rule", Action: action.String(), RuleID: ruleID})
 }
 
 func (n *Notifier) SendVariant(ctx context.Context, ruleID, variantID string, action ActionType) error {
-	return n.send(ctx, ruleID, variantID, "variant", action)
+	return n.send(ctx, Event{Type: "variant", Action: action.String(), RuleID: ruleID, VariantID: variantID})
+}
+
+// SendVariantClone announces a created variant whose arm statistics should be
+// copied from sourceVariantID.
+func (n *Notifier) SendVariantClone(ctx context.Context, ruleID, variantID, sourceVariantID string) error {
+	return n.send(ctx, Event{
+		Type:            "variant",
+		Action:          ActionCreate.String(),
+		RuleID:          ruleID,
+		VariantID:       variantID,
+		SourceVariantID: sourceVariantID,
+	})
 }
 
-func (n *Notifier) send(ctx context.Context, ruleID, variantID string, eventType string, action ActionType) error {
-	msg, err := json.Marshal(
-		Event{
-			Type:      eventType,
-			Action:    action.String(),
-			RuleID:    ruleID,
-			VariantID: variantID,
-		},
-	)
+func (n *Notifier) send(ctx context.Context, event Event) error {
+	msg, err := json.Marshal(event)
 	if err != nil {
-		return errors.Wrapf(err, "event '%s' marshal", eventType)
+		return errors.Wrapf(err, "event '%s' marshal", event.Type)
 	}
 
-	return n.producer.SendMessage(ctx, []byte(ruleID), msg)
+	return n.outbox.AddOutboxEvent(ctx, event.RuleID, msg)
 }
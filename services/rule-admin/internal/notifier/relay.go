@@ -0,0 +1,111 @@
+package notifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/EbumbaE/bandit/pkg/logger"
+	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+)
+
+type Producer interface {
+	SendMessage(ctx context.Context, key, value []byte) error
+}
+
+type RelayStorage interface {
+	RunInTx(ctx context.Context, exec func(ctx context.Context) error) error
+	TryLockOutbox(ctx context.Context) (bool, error)
+	GetOutboxEvents(ctx context.Context, limit int) ([]model.OutboxEvent, error)
+	DeleteOutboxEvent(ctx context.Context, id int64) error
+	FailOutboxEvent(ctx context.Context, id int64, reason string) error
+}
+
+// Relay publishes outbox events to kafka in insertion order. An event is deleted
+// only after kafka acknowledged it, so delivery is at-least-once.
+type Relay struct {
+	storage   RelayStorage
+	producer  Producer
+	interval  time.Duration
+	batchSize int
+}
+
+const (
+	defaultRelayInterval  = time.Second
+	defaultRelayBatchSize = 100
+)
+
+// NewRelay falls back to defaults for a non-positive interval or batch size: a zero
+// batch would never drain the outbox and time.NewTicker panics on a zero interval.
+func NewRelay(storage RelayStorage, producer Producer, interval time.Duration, batchSize int) *Relay {
+	if interval <= 0 {
+		interval = defaultRelayInterval
+	}
+	if batchSize <= 0 {
+		batchSize = defaultRelayBatchSize
+	}
+
+	return &Relay{
+		storage:   storage,
+		producer:  producer,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for {
+				sent, err := r.publishBatch(ctx)
+				if err != nil {
+					logger.Error("publish outbox events", zap.Error(err))
+				}
+				if err != nil || sent < r.batchSize {
+					break
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Relay) publishBatch(ctx context.Context) (int, error) {
+	sent := 0
+
+	err := r.storage.RunInTx(ctx, func(ctx context.Context) error {
+		locked, err := r.storage.TryLockOutbox(ctx)
+		if err != nil || !locked {
+			return err
+		}
+
+		events, err := r.storage.GetOutboxEvents(ctx, r.batchSize)
+		if err != nil {
+			return errors.Wrap(err, "get outbox events")
+		}
+
+		for _, e := range events {
+			if err := r.producer.SendMessage(ctx, []byte(e.Key), e.Payload); err != nil {
+				logger.Error("send outbox event",
+					zap.Int64("id", e.Id), zap.Int("attempts", e.Attempts+1), zap.Error(err))
+				// stop on the first failure to keep order, the event is retried on the next tick
+				return r.storage.FailOutboxEvent(ctx, e.Id, err.Error())
+			}
+			if err := r.storage.DeleteOutboxEvent(ctx, e.Id); err != nil {
+				return errors.Wrapf(err, "delete outbox event %d", e.Id)
+			}
+			sent++
+		}
+
+		return nil
+	})
+
+	return sent, err
+}
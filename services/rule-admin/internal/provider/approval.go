@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/EbumbaE/bandit/pkg/auth"
+	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/storage"
+)
+
+var (
+	ErrApprovalRequired    = errors.New("approval required")
+	ErrInvalidChangeStatus = errors.New("invalid change request status")
+	ErrProtectionDisabled  = errors.New("protected rules require authentication")
+)
+
+// schedulerActor authors change requests of scheduled state changes of protected rules.
+const schedulerActor = "scheduler"
+
+type ruleStatePayload struct {
+	State model.StateType `json:"state"`
+}
+
+// changeActor returns the authenticated subject, anonymous calls can't author or
+// review changes.
+func changeActor(ctx context.Context) (string, error) {
+	pr, ok := auth.PrincipalFromContext(ctx)
+	if !ok || len(pr.Subject) == 0 {
+		return "", fmt.Errorf("%w: change requests require an authenticated user", ErrForbidden)
+	}
+	return pr.Subject, nil
+}
+
+// checkProtection refuses to protect rules without auth, nobody could change them.
+func (p *Provider) checkProtection(protected bool) error {
+	if protected && !p.authEnabled {
+		return fmt.Errorf("%w: enable auth of rule-admin", ErrProtectionDisabled)
+	}
+	return nil
+}
+
+func (p *Provider) requestChange(ctx context.Context, op model.ChangeOperation, ruleID, service string, payload any) error {
+	author, err := changeActor(ctx)
+	if err != nil {
+		return err
+	}
+
+	cr, err := p.createChangeRequest(ctx, op, ruleID, service, payload, author, model.ChangeStatusDraft)
+	if err != nil {
+		return err
+	}
+
+	return fmt.Errorf("%w: change request %s", ErrApprovalRequired, cr.Id)
+}
+
+func (p *Provider) createChangeRequest(ctx context.Context, op model.ChangeOperation, ruleID, service string, payload any, author string, status model.ChangeStatus) (model.ChangeRequest, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return model.ChangeRequest{}, errors.Wrap(err, "marshal change payload")
+	}
+
+	cr, err := p.storage.CreateChangeRequest(ctx, model.ChangeRequest{
+		Operation: op,
+		RuleId:    ruleID,
+		Service:   service,
+		Payload:   raw,
+		Status:    status,
+		Author:    author,
+	})
+	if err != nil {
+		return model.ChangeRequest{}, errors.Wrap(err, "create change request")
+	}
+
+	return cr, nil
+}
+
+func (p *Provider) SetRuleProtected(ctx context.Context, id string, protected bool) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/SetRuleProtected")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, id); err != nil {
+		return err
+	}
+	if err := p.checkProtection(protected); err != nil {
+		return err
+	}
+
+	if _, err := p.storage.GetRuleServiceContext(ctx, id); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if err := p.storage.SetRuleProtected(ctx, id, protected); err != nil {
+		return err
+	}
+
+	p.recordRevision(ctx, id, fmt.Sprintf("set rule protected %t", protected))
+
+	return nil
+}
+
+func (p *Provider) GetChangeRequest(ctx context.Context, id string) (model.ChangeRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/GetChangeRequest")
+	defer span.Finish()
+
+	cr, err := p.storage.GetChangeRequest(ctx, id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.ChangeRequest{}, ErrNotFound
+		}
+		return model.ChangeRequest{}, err
+	}
+
+	if err := p.authorizeService(ctx, cr.Service); err != nil {
+		if errors.Is(err, ErrForbidden) {
+			return model.ChangeRequest{}, ErrNotFound
+		}
+		return model.ChangeRequest{}, err
+	}
+
+	return cr, nil
+}
+
+func (p *Provider) ListChangeRequests(ctx context.Context, filter model.ChangeRequestFilter) ([]model.ChangeRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ListChangeRequests")
+	defer span.Finish()
+
+	services, err := p.scopedServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if services != nil {
+		filter.Services = services
+	}
+
+	return p.storage.ListChangeRequests(ctx, filter)
+}
+
+func (p *Provider) SubmitChangeRequest(ctx context.Context, id string) (model.ChangeRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/SubmitChangeRequest")
+	defer span.Finish()
+
+	cr, err := p.GetChangeRequest(ctx, id)
+	if err != nil {
+		return model.ChangeRequest{}, err
+	}
+
+	author, err := changeActor(ctx)
+	if err != nil {
+		return model.ChangeRequest{}, err
+	}
+	if cr.Author != author {
+		return model.ChangeRequest{}, fmt.Errorf("%w: only author can submit change request", ErrForbidden)
+	}
+
+	return p.transitChangeRequest(ctx, cr, model.ChangeStatusPendingReview, "", "")
+}
+
+func (p *Provider) ApproveChangeRequest(ctx context.Context, id, comment string) (model.ChangeRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ApproveChangeRequest")
+	defer span.Finish()
+
+	cr, err := p.GetChangeRequest(ctx, id)
+	if err != nil {
+		return model.ChangeRequest{}, err
+	}
+
+	reviewer, err := changeActor(ctx)
+	if err != nil {
+		return model.ChangeRequest{}, err
+	}
+	if reviewer == cr.Author {
+		return model.ChangeRequest{}, fmt.Errorf("%w: change request must be approved by another user", ErrForbidden)
+	}
+
+	return p.transitChangeRequest(ctx, cr, model.ChangeStatusApproved, reviewer, comment)
+}
+
+func (p *Provider) RejectChangeRequest(ctx context.Context, id, comment string) (model.ChangeRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/RejectChangeRequest")
+	defer span.Finish()
+
+	cr, err := p.GetChangeRequest(ctx, id)
+	if err != nil {
+		return model.ChangeRequest{}, err
+	}
+
+	reviewer, err := changeActor(ctx)
+	if err != nil {
+		return model.ChangeRequest{}, err
+	}
+
+	return p.transitChangeRequest(ctx, cr, model.ChangeStatusRejected, reviewer, comment)
+}
+
+func (p *Provider) ApplyChangeRequest(ctx context.Context, id string) (model.ChangeRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ApplyChangeRequest")
+	defer span.Finish()
+
+	cr, err := p.GetChangeRequest(ctx, id)
+	if err != nil {
+		return model.ChangeRequest{}, err
+	}
+
+	// the status and the change commit together, a failed change leaves the request approved
+	err = p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := p.transitChangeRequest(ctx, cr, model.ChangeStatusApplied, "", ""); err != nil {
+			return err
+		}
+		return p.applyChange(ctx, cr)
+	})
+	if err != nil {
+		return model.ChangeRequest{}, err
+	}
+
+	return p.GetChangeRequest(ctx, id)
+}
+
+var changeTransitions = map[model.ChangeStatus][]model.ChangeStatus{
+	model.ChangeStatusPendingReview: {model.ChangeStatusDraft},
+	model.ChangeStatusApproved:      {model.ChangeStatusPendingReview},
+	model.ChangeStatusRejected:      {model.ChangeStatusDraft, model.ChangeStatusPendingReview},
+	model.ChangeStatusApplied:       {model.ChangeStatusApproved},
+}
+
+func (p *Provider) transitChangeRequest(ctx context.Context, cr model.ChangeRequest, to model.ChangeStatus, reviewer, comment string) (model.ChangeRequest, error) {
+	if !slices.Contains(changeTransitions[to], cr.Status) {
+		return model.ChangeRequest{}, fmt.Errorf("%w: %s -> %s", ErrInvalidChangeStatus, cr.Status, to)
+	}
+
+	if err := p.storage.SetChangeRequestStatus(ctx, cr.Id, cr.Status, to, reviewer, comment); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.ChangeRequest{}, fmt.Errorf("%w: changed concurrently", ErrInvalidChangeStatus)
+		}
+		return model.ChangeRequest{}, err
+	}
+
+	return p.GetChangeRequest(ctx, cr.Id)
+}
+
+func (p *Provider) applyChange(ctx context.Context, cr model.ChangeRequest) error {
+	switch cr.Operation {
+	case model.ChangeOperationCreateRule:
+		var r model.Rule
+		if err := json.Unmarshal(cr.Payload, &r); err != nil {
+			return errors.Wrap(err, "unmarshal rule")
+		}
+		if err := p.validateRule(ctx, r); err != nil {
+			return err
+		}
+		created, err := p.createRule(ctx, r)
+		if err != nil {
+			return err
+		}
+		return p.storage.SetChangeRequestRule(ctx, cr.Id, created.Id)
+	case model.ChangeOperationAddVariant:
+		var v model.Variant
+		if err := json.Unmarshal(cr.Payload, &v); err != nil {
+			return errors.Wrap(err, "unmarshal variant")
+		}
+		if _, err := p.validateVariant(ctx, cr.RuleId, v); err != nil {
+			return err
+		}
+		_, err := p.applyAddVariant(ctx, cr.RuleId, v)
+		return err
+	case model.ChangeOperationSetRuleState:
+		var payload ruleStatePayload
+		if err := json.Unmarshal(cr.Payload, &payload); err != nil {
+			return errors.Wrap(err, "unmarshal rule state")
+		}
+		return p.applyRuleState(ctx, cr.RuleId, payload.State)
+	case model.ChangeOperationMigrateBandit:
+		var payload banditMigrationPayload
+		if err := json.Unmarshal(cr.Payload, &payload); err != nil {
+			return errors.Wrap(err, "unmarshal bandit migration")
+		}
+		if err := p.checkBanditKey(ctx, payload.BanditKey); err != nil {
+			return err
+		}
+		return p.applyBanditMigration(ctx, cr.RuleId, payload.From, payload.BanditKey)
+	case model.ChangeOperationResetStats:
+		var payload resetStatsPayload
+		if err := json.Unmarshal(cr.Payload, &payload); err != nil {
+			return errors.Wrap(err, "unmarshal reset statistics")
+		}
+		return p.applyResetStatistics(ctx, cr.RuleId, payload.VariantID)
+	case model.ChangeOperationRollbackRule:
+		var payload ruleRollbackPayload
+		if err := json.Unmarshal(cr.Payload, &payload); err != nil {
+			return errors.Wrap(err, "unmarshal rule rollback")
+		}
+		_, err := p.applyRollback(ctx, cr.RuleId, payload.Revision)
+		return err
+	case model.ChangeOperationImportRule:
+		var change model.ImportChange
+		if err := json.Unmarshal(cr.Payload, &change); err != nil {
+			return errors.Wrap(err, "unmarshal import change")
+		}
+		return p.applyApprovedImport(ctx, change)
+	default:
+		return fmt.Errorf("unknown change operation %s", cr.Operation)
+	}
+}
@@ -0,0 +1,734 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/notifier"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/schema"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/storage"
+)
+
+var (
+	ErrInvalidImport        = errors.New("invalid import")
+	ErrDestructiveForbidden = errors.New("destructive change is not allowed")
+)
+
+const exportPageSize = 100
+
+var targetingOperators = []model.TargetingOperator{
+	model.TargetingOperatorEq,
+	model.TargetingOperatorIn,
+	model.TargetingOperatorNotIn,
+	model.TargetingOperatorVersionGte,
+	model.TargetingOperatorVersionLt,
+}
+
+func (p *Provider) ExportRules(ctx context.Context, service, context string) ([]model.Rule, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ExportRules")
+	defer span.Finish()
+
+	if len(service) > 0 {
+		if err := p.authorizeService(ctx, service); err != nil {
+			return nil, err
+		}
+	}
+
+	rules, err := p.listRulesWithVariants(ctx, model.RuleFilter{Service: service, Context: context})
+	if err != nil {
+		return nil, err
+	}
+
+	layerNames := make(map[string]string)
+	for i, r := range rules {
+		if len(r.LayerId) == 0 {
+			continue
+		}
+		name, ok := layerNames[r.LayerId]
+		if !ok {
+			layer, err := p.storage.GetLayer(ctx, r.LayerId)
+			if err != nil {
+				return nil, errors.Wrapf(err, "get layer %s", r.LayerId)
+			}
+			name = layer.Name
+			layerNames[r.LayerId] = name
+		}
+		rules[i].LayerName = name
+	}
+
+	return rules, nil
+}
+
+func (p *Provider) listRulesWithVariants(ctx context.Context, filter model.RuleFilter) ([]model.Rule, error) {
+	rules, err := p.listRules(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, r := range rules {
+		rules[i].Variants, err = p.storage.GetVariants(ctx, r.Id)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return nil, errors.Wrapf(err, "get variants of rule %s", r.Id)
+		}
+	}
+
+	return rules, nil
+}
+
+// listRules reads all pages of rules matching the filter.
+func (p *Provider) listRules(ctx context.Context, filter model.RuleFilter) ([]model.Rule, error) {
+	filter.Limit = exportPageSize
+
+	var res []model.Rule
+	for {
+		rules, cursor, err := p.ListRules(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, rules...)
+
+		if cursor == nil {
+			return res, nil
+		}
+		filter.Cursor = cursor
+	}
+}
+
+// ImportRules brings rules of the document services to the described state. Rules are
+// matched by service, context and name, variants by name. Variants missing in the
+// document are disabled, missing rules are disabled only with opts.Prune. The whole
+// import is applied in one transaction; with opts.DryRun only the plan is returned.
+// With opts.GuardDestructive the plan being applied is checked against opts.AllowDestructive.
+func (p *Provider) ImportRules(ctx context.Context, rules []model.Rule, opts model.ImportOptions) ([]model.ImportChange, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ImportRules")
+	defer span.Finish()
+
+	plan, err := p.planImport(ctx, rules, opts.Prune)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.DryRun || len(plan) == 0 {
+		return plan, nil
+	}
+
+	if opts.GuardDestructive {
+		for _, change := range plan {
+			key := change.Service + "/" + change.Context + "/" + change.Name
+			if change.Destructive && !change.Pruned && !opts.AllowDestructive[key] {
+				return nil, fmt.Errorf("%w: rule %s: %s", ErrDestructiveForbidden, key, strings.Join(change.Details, ", "))
+			}
+		}
+	}
+
+	err = p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		for i, change := range plan {
+			if change.Protected {
+				crID, err := p.requestImportChange(ctx, change)
+				if err != nil {
+					return errors.Wrapf(err, "request %s rule %s/%s/%s", change.Action, change.Service, change.Context, change.Name)
+				}
+				plan[i].Details = append(plan[i].Details, fmt.Sprintf("change request %s", crID))
+				continue
+			}
+
+			id, err := p.applyImportChange(ctx, change)
+			if err != nil {
+				return errors.Wrapf(err, "%s rule %s/%s/%s", change.Action, change.Service, change.Context, change.Name)
+			}
+			plan[i].RuleId = id
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range plan {
+		if change.Action != model.ImportActionCreate && !change.Protected {
+			p.recordRevision(ctx, change.RuleId, "import rules")
+		}
+	}
+
+	return plan, nil
+}
+
+type ruleKey struct {
+	service, context, name string
+}
+
+func (p *Provider) planImport(ctx context.Context, rules []model.Rule, prune bool) ([]model.ImportChange, error) {
+	desired := make(map[ruleKey]model.Rule, len(rules))
+	var services []string
+	for _, r := range rules {
+		if err := checkImportRule(r); err != nil {
+			return nil, err
+		}
+
+		key := ruleKey{r.Service, r.Context, r.Name}
+		if _, ok := desired[key]; ok {
+			return nil, fmt.Errorf("%w: duplicate rule %s/%s/%s", ErrInvalidImport, r.Service, r.Context, r.Name)
+		}
+		desired[key] = r
+
+		if !slices.Contains(services, r.Service) {
+			if err := p.authorizeService(ctx, r.Service); err != nil {
+				return nil, err
+			}
+			services = append(services, r.Service)
+		}
+	}
+	sort.Strings(services)
+
+	existing := make(map[ruleKey]model.Rule)
+	var current []model.Rule
+	for _, service := range services {
+		rules, err := p.listRulesWithVariants(ctx, model.RuleFilter{Service: service})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rules {
+			key := ruleKey{r.Service, r.Context, r.Name}
+			if _, ok := existing[key]; ok {
+				if _, wanted := desired[key]; wanted {
+					return nil, fmt.Errorf("%w: ambiguous rule name %s/%s/%s", ErrInvalidImport, r.Service, r.Context, r.Name)
+				}
+			}
+			existing[key] = r
+			current = append(current, r)
+		}
+	}
+
+	layerIDs := make(map[string]string)
+	var plan []model.ImportChange
+	for _, r := range rules {
+		if len(r.LayerName) > 0 {
+			id, ok := layerIDs[r.LayerName]
+			if !ok {
+				var err error
+				if id, err = p.importLayer(ctx, r.LayerName); err != nil {
+					return nil, err
+				}
+				layerIDs[r.LayerName] = id
+			}
+			r.LayerId = id
+		}
+		r = deferScheduledStart(r)
+		if err := p.checkProtection(r.Protected); err != nil {
+			return nil, fmt.Errorf("%w: rule %s/%s/%s", err, r.Service, r.Context, r.Name)
+		}
+
+		cur, ok := existing[ruleKey{r.Service, r.Context, r.Name}]
+		if !ok {
+			protected, err := p.storage.HasProtectedRule(ctx, r.Service, r.Context)
+			if err != nil {
+				return nil, err
+			}
+
+			change := importChange(model.ImportActionCreate, r)
+			change.Details = append(change.Details, fmt.Sprintf("variants: %d", len(r.Variants)))
+			change.Protected = protected || r.Protected
+			if change.Protected {
+				change.Details = append(change.Details, "protected rule, requires approval")
+			}
+			plan = append(plan, change)
+			continue
+		}
+
+		if cur.BanditKey != r.BanditKey {
+			return nil, fmt.Errorf("%w: bandit_key of rule %s can't be changed by import", ErrInvalidImport, cur.Id)
+		}
+
+		r.Id = cur.Id
+		details, destructive := diffRule(cur, r)
+		if len(details) == 0 {
+			continue
+		}
+
+		action := model.ImportActionUpdate
+		if cur.State == model.StateTypeEnable && r.State == model.StateTypeDisable {
+			action = model.ImportActionDisable
+		}
+		if cur.Protected {
+			details = append(details, "protected rule, requires approval")
+		}
+
+		change := importChange(action, r)
+		change.Details = details
+		change.Protected = cur.Protected
+		change.Destructive = destructive || action == model.ImportActionDisable
+		plan = append(plan, change)
+	}
+
+	if prune {
+		for _, cur := range current {
+			if _, ok := desired[ruleKey{cur.Service, cur.Context, cur.Name}]; ok || cur.State != model.StateTypeEnable {
+				continue
+			}
+			change := importChange(model.ImportActionDisable, cur)
+			change.Pruned = true
+			change.Destructive = true
+			change.Details = []string{"missing in document"}
+			change.Protected = cur.Protected
+			if cur.Protected {
+				change.Details = append(change.Details, "protected rule, requires approval")
+			}
+			plan = append(plan, change)
+		}
+	}
+
+	// disables go first to free default rule slots for the rest of the plan
+	sort.SliceStable(plan, func(i, j int) bool {
+		return importOrder(plan[i].Action) < importOrder(plan[j].Action)
+	})
+
+	return plan, nil
+}
+
+// importLayer resolves a layer name of the document to the id of the only layer
+// with that name visible to the caller.
+func (p *Provider) importLayer(ctx context.Context, name string) (string, error) {
+	layers, err := p.storage.GetLayersByName(ctx, name)
+	if err != nil {
+		return "", errors.Wrapf(err, "get layers %s", name)
+	}
+
+	var ids []string
+	for _, l := range layers {
+		if p.authorizeLayer(ctx, l) == nil {
+			ids = append(ids, l.Id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("%w: unknown layer %s", ErrInvalidImport, name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%w: ambiguous layer name %s", ErrInvalidImport, name)
+	}
+}
+
+// deferScheduledStart disables the rule and variants starting later, the same way
+// createRule and addVariant do, so the plan compares with what will be stored.
+func deferScheduledStart(r model.Rule) model.Rule {
+	now := time.Now().UTC()
+	if r.StartAt != nil && r.StartAt.After(now) {
+		r.State = model.StateTypeDisable
+	}
+
+	variants := make([]model.Variant, 0, len(r.Variants))
+	for _, v := range r.Variants {
+		if v.StartAt != nil && v.StartAt.After(now) {
+			v.State = model.StateTypeDisable
+		}
+		variants = append(variants, v)
+	}
+	r.Variants = variants
+
+	return r
+}
+
+func importChange(action model.ImportAction, r model.Rule) model.ImportChange {
+	return model.ImportChange{
+		Action:  action,
+		RuleId:  r.Id,
+		Service: r.Service,
+		Context: r.Context,
+		Name:    r.Name,
+		Rule:    r,
+	}
+}
+
+func importOrder(action model.ImportAction) int {
+	switch action {
+	case model.ImportActionDisable:
+		return 0
+	case model.ImportActionUpdate:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func checkImportRule(r model.Rule) error {
+	if len(r.Service) == 0 || len(r.Context) == 0 || len(r.Name) == 0 || len(r.BanditKey) == 0 {
+		return fmt.Errorf("%w: rule requires service, context, name and bandit_key", ErrInvalidImport)
+	}
+
+	if !validState(r.State) {
+		return fmt.Errorf("%w: rule %s: unknown state %s", ErrInvalidImport, r.Name, r.State)
+	}
+
+	for _, c := range r.Targeting {
+		if len(c.Attribute) == 0 || len(c.Values) == 0 || !slices.Contains(targetingOperators, c.Operator) {
+			return fmt.Errorf("%w: rule %s: invalid targeting condition on %q", ErrInvalidImport, r.Name, c.Attribute)
+		}
+	}
+
+	names := make(map[string]struct{}, len(r.Variants))
+	for _, v := range r.Variants {
+		if len(v.Name) == 0 {
+			return fmt.Errorf("%w: rule %s: variant without name", ErrInvalidImport, r.Name)
+		}
+		if _, ok := names[v.Name]; ok {
+			return fmt.Errorf("%w: rule %s: duplicate variant %s", ErrInvalidImport, r.Name, v.Name)
+		}
+		names[v.Name] = struct{}{}
+
+		if !validState(v.State) {
+			return fmt.Errorf("%w: rule %s: variant %s: unknown state %s", ErrInvalidImport, r.Name, v.Name, v.State)
+		}
+	}
+
+	return nil
+}
+
+func validState(state model.StateType) bool {
+	return state == model.StateTypeEnable || state == model.StateTypeDisable
+}
+
+func diffRule(cur, want model.Rule) ([]string, bool) {
+	var (
+		details     []string
+		destructive bool
+	)
+
+	if cur.Description != want.Description {
+		details = append(details, "description")
+	}
+	if cur.Priority != want.Priority {
+		details = append(details, fmt.Sprintf("priority: %d -> %d", cur.Priority, want.Priority))
+	}
+	if !sameTargeting(cur.Targeting, want.Targeting) {
+		details = append(details, "targeting")
+	}
+	if cur.VariantSchema != want.VariantSchema {
+		details = append(details, "variant_schema")
+	}
+	if cur.Protected != want.Protected {
+		details = append(details, fmt.Sprintf("protected: %t -> %t", cur.Protected, want.Protected))
+	}
+	if len(want.BanditConfig) > 0 && !sameData(cur.BanditConfig, want.BanditConfig) {
+		details = append(details, "bandit_config")
+	}
+	if cur.LayerId != want.LayerId {
+		details = append(details, "layer")
+	}
+	if !sameTime(cur.StartAt, want.StartAt) || !sameTime(cur.EndAt, want.EndAt) {
+		details = append(details, "schedule")
+	}
+	if cur.State != want.State {
+		details = append(details, fmt.Sprintf("state: %s -> %s", cur.State, want.State))
+	}
+
+	curVariants := variantsByName(cur.Variants)
+	for _, v := range want.Variants {
+		cv, ok := curVariants[v.Name]
+		if !ok {
+			details = append(details, fmt.Sprintf("add variant %s", v.Name))
+			continue
+		}
+		if !sameData(cv.Data, v.Data) {
+			details = append(details, fmt.Sprintf("update variant %s", v.Name))
+		}
+		if !sameTime(cv.StartAt, v.StartAt) || !sameTime(cv.EndAt, v.EndAt) {
+			details = append(details, fmt.Sprintf("variant %s schedule", v.Name))
+		}
+		if cv.State != v.State {
+			details = append(details, fmt.Sprintf("variant %s state: %s -> %s", v.Name, cv.State, v.State))
+			destructive = destructive || v.State == model.StateTypeDisable
+		}
+	}
+
+	wantVariants := variantsByName(want.Variants)
+	for _, v := range cur.Variants {
+		if _, ok := wantVariants[v.Name]; !ok && v.State == model.StateTypeEnable {
+			details = append(details, fmt.Sprintf("disable variant %s", v.Name))
+			destructive = true
+		}
+	}
+
+	return details, destructive
+}
+
+func sameTargeting(a, b []model.TargetingCondition) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	rawA, errA := json.Marshal(a)
+	rawB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(rawA) == string(rawB)
+}
+
+// sameData compares variant data as json, since jsonb doesn't keep the original formatting.
+func sameData(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var va, vb any
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+func variantsByName(variants []model.Variant) map[string]model.Variant {
+	res := make(map[string]model.Variant, len(variants))
+	for _, v := range variants {
+		res[v.Name] = v
+	}
+	return res
+}
+
+func (p *Provider) applyImportChange(ctx context.Context, change model.ImportChange) (string, error) {
+	r := change.Rule
+
+	// runs inside the import transaction, so quotas account for the changes applied before
+	if change.Action == model.ImportActionCreate {
+		protected, err := p.storage.HasProtectedRule(ctx, r.Service, r.Context)
+		if err != nil {
+			return "", err
+		}
+		if protected || r.Protected {
+			return "", fmt.Errorf("%w: protected rules can't be imported", ErrApprovalRequired)
+		}
+		if err := p.validateRule(ctx, r); err != nil {
+			return "", err
+		}
+
+		created, err := p.createRule(ctx, r)
+		if err != nil {
+			return "", err
+		}
+		return created.Id, nil
+	}
+
+	cur, err := p.GetRule(ctx, r.Id)
+	if err != nil {
+		return "", err
+	}
+	if cur.Protected {
+		return "", fmt.Errorf("%w: rule %s is protected", ErrApprovalRequired, cur.Id)
+	}
+
+	return r.Id, p.importRuleUpdate(ctx, cur, change)
+}
+
+// requestImportChange records a change of a protected rule as a change request, it is
+// applied the same way as by the import once approved.
+func (p *Provider) requestImportChange(ctx context.Context, change model.ImportChange) (string, error) {
+	author, err := changeActor(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	op, payload := model.ChangeOperationImportRule, any(change)
+	switch {
+	case change.Action == model.ImportActionCreate:
+		op, payload = model.ChangeOperationCreateRule, change.Rule
+	case change.Pruned:
+		op, payload = model.ChangeOperationSetRuleState, ruleStatePayload{State: model.StateTypeDisable}
+	}
+
+	cr, err := p.createChangeRequest(ctx, op, change.RuleId, change.Service, payload, author, model.ChangeStatusDraft)
+	if err != nil {
+		return "", err
+	}
+
+	return cr.Id, nil
+}
+
+func (p *Provider) applyApprovedImport(ctx context.Context, change model.ImportChange) error {
+	err := p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		cur, err := p.GetRule(ctx, change.RuleId)
+		if err != nil {
+			return err
+		}
+		return p.importRuleUpdate(ctx, cur, change)
+	})
+	if err != nil {
+		return err
+	}
+
+	p.recordRevision(ctx, change.RuleId, "import rules")
+
+	return nil
+}
+
+func (p *Provider) importRuleUpdate(ctx context.Context, cur model.Rule, change model.ImportChange) error {
+	r := change.Rule
+
+	if change.Pruned {
+		return p.setRuleState(ctx, r.Id, model.StateTypeDisable)
+	}
+
+	if err := p.validateImportVariants(ctx, cur, r); err != nil {
+		return err
+	}
+
+	if cur.State == model.StateTypeEnable && r.State == model.StateTypeDisable {
+		if err := p.setRuleState(ctx, r.Id, r.State); err != nil {
+			return err
+		}
+	}
+
+	if _, err := p.storage.UpdateRule(ctx, r); err != nil {
+		return err
+	}
+	if err := p.storage.SetRuleTargeting(ctx, r.Id, r.Priority, r.Targeting); err != nil {
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			return fmt.Errorf("%w: rule %s", ErrDefaultRuleExists, r.Id)
+		}
+		return err
+	}
+	if err := p.storage.SetRuleVariantSchema(ctx, r.Id, r.VariantSchema); err != nil {
+		return err
+	}
+	if len(r.BanditConfig) > 0 && !sameData(cur.BanditConfig, r.BanditConfig) {
+		config, err := p.banditConfig(ctx, r.BanditKey, r.BanditConfig)
+		if err != nil {
+			return err
+		}
+		if err := p.storage.SetRuleBanditConfig(ctx, r.Id, config); err != nil {
+			return err
+		}
+	}
+	if cur.LayerId != r.LayerId {
+		if err := p.storage.SetRuleLayer(ctx, r.Id, r.LayerId); err != nil {
+			return err
+		}
+	}
+	if !sameTime(cur.StartAt, r.StartAt) || !sameTime(cur.EndAt, r.EndAt) {
+		if err := p.storage.SetRuleSchedule(ctx, r.Id, r.StartAt, r.EndAt); err != nil {
+			return err
+		}
+	}
+	if cur.Protected != r.Protected {
+		if err := p.storage.SetRuleProtected(ctx, r.Id, r.Protected); err != nil {
+			return err
+		}
+	}
+	if err := p.notifier.SendRule(ctx, r.Id, notifier.ActionUpdate); err != nil {
+		return errors.Wrap(err, "send update rule event")
+	}
+
+	if err := p.importVariants(ctx, r.Id, cur.Variants, r.Variants); err != nil {
+		return err
+	}
+
+	if cur.State == model.StateTypeDisable && r.State == model.StateTypeEnable {
+		if err := p.setRuleState(ctx, r.Id, r.State); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateImportVariants checks the target schema against every variant the rule keeps,
+// including the ones only disabled by the import.
+func (p *Provider) validateImportVariants(ctx context.Context, cur, want model.Rule) error {
+	if len(want.VariantSchema) > 0 {
+		if _, err := schema.Compile(want.VariantSchema); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidSchema, err)
+		}
+	}
+
+	for _, v := range want.Variants {
+		if err := validateVariantData(want.VariantSchema, v.Data); err != nil {
+			return fmt.Errorf("variant %s: %w", v.Name, err)
+		}
+	}
+
+	wantVariants := variantsByName(want.Variants)
+	for _, v := range cur.Variants {
+		if _, ok := wantVariants[v.Name]; ok {
+			continue
+		}
+		if err := validateVariantData(want.VariantSchema, v.Data); err != nil {
+			return fmt.Errorf("%w: variant[%s]: %s", ErrIncompatibleSchema, v.Id, err)
+		}
+	}
+
+	return p.checkVariantQuota(ctx, want.Service, countMissingNames(cur.Variants, want.Variants))
+}
+
+func countMissingNames(current, target []model.Variant) int {
+	names := variantsByName(current)
+
+	missing := 0
+	for _, v := range target {
+		if _, ok := names[v.Name]; !ok {
+			missing++
+		}
+	}
+	return missing
+}
+
+func (p *Provider) importVariants(ctx context.Context, ruleID string, current, target []model.Variant) error {
+	currentByName := variantsByName(current)
+
+	for _, v := range target {
+		cur, ok := currentByName[v.Name]
+		if !ok {
+			if _, err := p.addVariant(ctx, ruleID, v); err != nil {
+				return errors.Wrapf(err, "add variant %s", v.Name)
+			}
+			continue
+		}
+
+		if !sameData(cur.Data, v.Data) {
+			cur.Data = v.Data
+			if _, err := p.storage.UpdateVariant(ctx, cur); err != nil {
+				return errors.Wrapf(err, "update variant %s", cur.Id)
+			}
+			if err := p.notifier.SendVariant(ctx, ruleID, cur.Id, notifier.ActionUpdate); err != nil {
+				return errors.Wrap(err, "send update variant event")
+			}
+		}
+
+		if !sameTime(cur.StartAt, v.StartAt) || !sameTime(cur.EndAt, v.EndAt) {
+			if err := p.storage.SetVariantSchedule(ctx, cur.Id, v.StartAt, v.EndAt); err != nil {
+				return errors.Wrapf(err, "set variant %s schedule", cur.Id)
+			}
+		}
+
+		if cur.State != v.State {
+			if err := p.setVariantState(ctx, ruleID, cur.Id, v.State); err != nil {
+				return errors.Wrapf(err, "set variant %s state", cur.Id)
+			}
+		}
+	}
+
+	targetByName := variantsByName(target)
+	for _, v := range current {
+		if _, ok := targetByName[v.Name]; ok || v.State != model.StateTypeEnable {
+			continue
+		}
+		if err := p.setVariantState(ctx, ruleID, v.Id, model.StateTypeDisable); err != nil {
+			return errors.Wrapf(err, "disable variant %s", v.Id)
+		}
+	}
+
+	return nil
+}
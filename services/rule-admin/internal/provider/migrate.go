@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	core "github.com/EbumbaE/bandit/services/bandit-core/v6"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/notifier"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/storage"
+)
+
+var ErrInvalidMigration = errors.New("invalid bandit migration")
+
+type banditMigrationPayload struct {
+	From      string `json:"from"`
+	BanditKey string `json:"bandit_key"`
+}
+
+// MigrateRuleBandit switches the rule to another bandit algorithm with the default config
+// of the new bandit key. The indexer converts learned arm statistics on the migrate event
+// and bumps the bandit version.
+func (p *Provider) MigrateRuleBandit(ctx context.Context, id, banditKey string) (model.Rule, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/MigrateRuleBandit")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, id); err != nil {
+		return model.Rule{}, err
+	}
+
+	r, err := p.storage.GetRule(ctx, id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.Rule{}, ErrNotFound
+		}
+		return model.Rule{}, err
+	}
+
+	if r.BanditKey == banditKey {
+		return model.Rule{}, fmt.Errorf("%w: rule %s already uses %s", ErrInvalidMigration, id, banditKey)
+	}
+
+	if err := p.checkBanditKey(ctx, banditKey); err != nil {
+		return model.Rule{}, err
+	}
+	// bandit-indexer converts arms only between implemented algorithms
+	if !core.Implemented(banditKey) {
+		return model.Rule{}, fmt.Errorf("%w: %s has no bandit implementation", ErrInvalidMigration, banditKey)
+	}
+
+	if r.Protected {
+		return model.Rule{}, p.requestChange(ctx, model.ChangeOperationMigrateBandit, id, r.Service,
+			banditMigrationPayload{From: r.BanditKey, BanditKey: banditKey})
+	}
+
+	if err := p.applyBanditMigration(ctx, id, r.BanditKey, banditKey); err != nil {
+		return model.Rule{}, err
+	}
+
+	return p.GetRule(ctx, id)
+}
+
+func (p *Provider) applyBanditMigration(ctx context.Context, id, from, banditKey string) error {
+	config, err := p.banditConfig(ctx, banditKey, "")
+	if err != nil {
+		return err
+	}
+
+	err = p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if err := p.storage.SetRuleBanditKey(ctx, id, banditKey); err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+		if err := p.storage.SetRuleBanditConfig(ctx, id, config); err != nil {
+			return err
+		}
+
+		return errors.Wrap(p.notifier.SendRule(ctx, id, notifier.ActionMigrate), "send migrate rule event")
+	})
+	if err != nil {
+		return err
+	}
+
+	p.recordRevision(ctx, id, fmt.Sprintf("migrate bandit %s -> %s", from, banditKey))
+
+	return nil
+}
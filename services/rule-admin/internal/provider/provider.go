@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
@@ -11,43 +12,114 @@ import (
 	"github.com/EbumbaE/bandit/pkg/logger"
 	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
 	"github.com/EbumbaE/bandit/services/rule-admin/internal/notifier"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/schema"
 	"github.com/EbumbaE/bandit/services/rule-admin/internal/storage"
 )
 
-var ErrNotFound = errors.New("not found")
+var (
+	ErrNotFound          = errors.New("not found")
+	ErrDefaultRuleExists = errors.New("active default rule already exist")
+
+	ErrInvalidSchema      = errors.New("invalid variant schema")
+	ErrInvalidVariantData = errors.New("variant data does not match schema")
+	ErrIncompatibleSchema = errors.New("variant schema is incompatible with existing variants")
+)
 
 type Storage interface {
 	GetRule(ctx context.Context, id string) (model.Rule, error)
+	ListRules(ctx context.Context, filter model.RuleFilter) ([]model.Rule, error)
+	RunInTx(ctx context.Context, exec func(ctx context.Context) error) error
+
 	CreateRule(ctx context.Context, rule model.Rule) (model.Rule, error)
 	UpdateRule(ctx context.Context, rule model.Rule) (model.Rule, error)
 	SetRuleState(ctx context.Context, id string, state model.StateType) error
-	GetRuleServiceContext(ctx context.Context, ruleID string) (string, string, error)
+	DeleteRule(ctx context.Context, id string) error
+	GetRuleServiceContext(ctx context.Context, ruleID string) (model.Rule, error)
+	SetRuleLayer(ctx context.Context, id, layerID string) error
+	SetRuleSchedule(ctx context.Context, id string, startAt, endAt *time.Time) error
+	SetRuleTargeting(ctx context.Context, id string, priority int32, targeting []model.TargetingCondition) error
+	SetRuleVariantSchema(ctx context.Context, id, variantSchema string) error
+	SetRuleBanditConfig(ctx context.Context, id, banditConfig string) error
+	SetRuleBanditKey(ctx context.Context, id, banditKey string) error
 	GetActiveRuleByServiceContext(ctx context.Context, service, context string) (string, error)
 
 	GetVariant(ctx context.Context, ruleID, variantID string) (model.Variant, error)
 	GetVariants(ctx context.Context, ruleID string) ([]model.Variant, error)
 	AddVariant(ctx context.Context, ruleID string, v model.Variant) (model.Variant, error)
 	SetVariantState(ctx context.Context, id string, state model.StateType) error
+	SetVariantSchedule(ctx context.Context, id string, startAt, endAt *time.Time) error
+	UpdateVariant(ctx context.Context, v model.Variant) (model.Variant, error)
+	DeleteVariant(ctx context.Context, id string) error
+	RestoreVariant(ctx context.Context, ruleID string, v model.Variant) error
+
+	CreateLayer(ctx context.Context, layer model.Layer) (model.Layer, error)
+	GetLayer(ctx context.Context, id string) (model.Layer, error)
+	GetLayersByName(ctx context.Context, name string) ([]model.Layer, error)
+	GetLayerActiveRules(ctx context.Context, layerID string) ([]string, error)
+
+	AddGuardrail(ctx context.Context, g model.Guardrail) (model.Guardrail, error)
+	GetGuardrails(ctx context.Context, ruleID string) ([]model.Guardrail, error)
+	GetActiveGuardrails(ctx context.Context, services []string) ([]model.Guardrail, error)
+	GetGuardrail(ctx context.Context, id string) (model.Guardrail, error)
+	DeleteGuardrail(ctx context.Context, id string) error
+
+	AddRuleRevision(ctx context.Context, ruleID, reason string, snapshot []byte) (uint64, error)
+	GetRuleRevisions(ctx context.Context, ruleID string) ([]model.RuleRevision, error)
+	GetRuleRevision(ctx context.Context, ruleID string, revision uint64) (model.RuleRevision, error)
+
+	AddAuditEvent(ctx context.Context, e model.AuditEvent) error
+	ListAuditEvents(ctx context.Context, filter model.AuditFilter) ([]model.AuditEvent, error)
+
+	CreateTenant(ctx context.Context, t model.Tenant) error
+	UpdateTenant(ctx context.Context, t model.Tenant) error
+	GetTenant(ctx context.Context, name string) (model.Tenant, error)
+	ListTenants(ctx context.Context) ([]model.Tenant, error)
+	SetTenantServices(ctx context.Context, name string, services []string) error
+	GetServiceTenant(ctx context.Context, service string) (string, error)
+	CountTenantRules(ctx context.Context, name string) (int, error)
+	LockTenant(ctx context.Context, name string) error
+	CountTenantVariants(ctx context.Context, name string) (int, error)
+
+	CreateRuleTemplate(ctx context.Context, t model.RuleTemplate) error
+	GetRuleTemplate(ctx context.Context, name string) (model.RuleTemplate, error)
+	ListRuleTemplates(ctx context.Context, tenants []string) ([]model.RuleTemplate, error)
+	DeleteRuleTemplate(ctx context.Context, name string) error
+
+	SetRuleProtected(ctx context.Context, id string, protected bool) error
+	HasProtectedRule(ctx context.Context, service, context string) (bool, error)
+	CreateChangeRequest(ctx context.Context, cr model.ChangeRequest) (model.ChangeRequest, error)
+	GetChangeRequest(ctx context.Context, id string) (model.ChangeRequest, error)
+	ListChangeRequests(ctx context.Context, filter model.ChangeRequestFilter) ([]model.ChangeRequest, error)
+	SetChangeRequestStatus(ctx context.Context, id string, from, to model.ChangeStatus, reviewer, comment string) error
+	SetChangeRequestRule(ctx context.Context, id, ruleID string) error
 
 	CreateWantedBandit(ctx context.Context, wb model.WantedBandit) error
 	GetWantedRegistry(ctx context.Context) ([]model.WantedBandit, error)
-	CheckWantedBandit(ctx context.Context, banditKey string) (bool, error)
+	GetWantedBandit(ctx context.Context, banditKey string) (model.WantedBandit, error)
+	UpdateWantedBandit(ctx context.Context, wb model.WantedBandit) error
+	SetWantedBanditDeprecated(ctx context.Context, banditKey string, deprecated bool, reason string) error
+	ListStaleBanditRules(ctx context.Context, services []string) ([]model.Rule, error)
 }
 
 type Notifier interface {
 	SendRule(ctx context.Context, ruleID string, action notifier.ActionType) error
 	SendVariant(ctx context.Context, ruleID, variantID string, action notifier.ActionType) error
+	SendVariantClone(ctx context.Context, ruleID, variantID, sourceVariantID string) error
 }
 
 type Provider struct {
 	storage  Storage
 	notifier Notifier
+
+	// authEnabled allows protected rules, their change requests need an authenticated user
+	authEnabled bool
 }
 
-func NewProvider(storage Storage, notifier Notifier) *Provider {
+func NewProvider(storage Storage, notifier Notifier, authEnabled bool) *Provider {
 	return &Provider{
-		storage:  storage,
-		notifier: notifier,
+		storage:     storage,
+		notifier:    notifier,
+		authEnabled: authEnabled,
 	}
 }
 
@@ -55,6 +127,10 @@ func (p *Provider) GetRule(ctx context.Context, id string) (model.Rule, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/GetRule")
 	defer span.Finish()
 
+	if err := p.authorizeRule(ctx, id); err != nil {
+		return model.Rule{}, err
+	}
+
 	r, err := p.storage.GetRule(ctx, id)
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
@@ -72,46 +148,178 @@ func (p *Provider) GetRule(ctx context.Context, id string) (model.Rule, error) {
 	return r, nil
 }
 
+func (p *Provider) ListRules(ctx context.Context, filter model.RuleFilter) ([]model.Rule, *model.RuleCursor, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ListRules")
+	defer span.Finish()
+
+	services, err := p.scopedServices(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if services != nil {
+		filter.Services = services
+	}
+
+	limit := filter.Limit
+	filter.Limit = limit + 1
+
+	rules, err := p.storage.ListRules(ctx, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(rules) <= limit {
+		return rules, nil, nil
+	}
+
+	rules = rules[:limit]
+	last := rules[limit-1]
+
+	cursor := &model.RuleCursor{Field: filter.SortField, Id: last.Id}
+	switch filter.SortField {
+	case model.RuleSortFieldUpdatedAt:
+		cursor.Value = last.UpdatedAt.Format(time.RFC3339Nano)
+	case model.RuleSortFieldName:
+		cursor.Value = last.Name
+	default:
+		cursor.Value = last.CreatedAt.Format(time.RFC3339Nano)
+	}
+
+	return rules, cursor, nil
+}
+
 func (p *Provider) CreateRule(ctx context.Context, r model.Rule) (model.Rule, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/CreateRule")
 	defer span.Finish()
 
-	ok, err := p.storage.CheckWantedBandit(ctx, r.BanditKey)
-	if err != nil {
+	if err := p.authorizeService(ctx, r.Service); err != nil {
 		return model.Rule{}, err
 	}
-	if !ok {
-		return model.Rule{}, errors.New("validate bandit key")
-	}
 
-	id, err := p.storage.GetActiveRuleByServiceContext(ctx, r.Service, r.Context)
-	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+	if err := p.validateRule(ctx, r); err != nil {
 		return model.Rule{}, err
 	}
-	if len(id) > 0 {
-		return model.Rule{}, fmt.Errorf("active rule already exist[%s]", id)
+	if err := p.checkProtection(r.Protected); err != nil {
+		return model.Rule{}, err
 	}
 
-	variants := r.Variants
-	r.Variants = nil
+	protected := r.Protected
+	if !protected {
+		var err error
+		protected, err = p.storage.HasProtectedRule(ctx, r.Service, r.Context)
+		if err != nil {
+			return model.Rule{}, err
+		}
+	}
+	if protected {
+		return model.Rule{}, p.requestChange(ctx, model.ChangeOperationCreateRule, "", r.Service, r)
+	}
+
+	return p.createRule(ctx, r)
+}
 
-	r, err = p.storage.CreateRule(ctx, r)
+func (p *Provider) validateRule(ctx context.Context, r model.Rule) error {
+	if err := p.checkBanditKey(ctx, r.BanditKey); err != nil {
+		return err
+	}
+	if _, err := p.banditConfig(ctx, r.BanditKey, r.BanditConfig); err != nil {
+		return err
+	}
+
+	if len(r.LayerId) > 0 {
+		layer, err := p.storage.GetLayer(ctx, r.LayerId)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+		if err := p.authorizeLayer(ctx, layer); err != nil {
+			return err
+		}
+	}
+
+	if len(r.VariantSchema) > 0 {
+		if _, err := schema.Compile(r.VariantSchema); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidSchema, err)
+		}
+	}
+
+	for _, v := range r.Variants {
+		if err := validateVariantData(r.VariantSchema, v.Data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Provider) createRule(ctx context.Context, r model.Rule) (model.Rule, error) {
+	if r.StartAt != nil && r.StartAt.After(time.Now().UTC()) {
+		r.State = model.StateTypeDisable
+	}
+
+	config, err := p.banditConfig(ctx, r.BanditKey, r.BanditConfig)
 	if err != nil {
 		return model.Rule{}, err
 	}
+	r.BanditConfig = config
 
-	if err := p.notifier.SendRule(ctx, r.Id, notifier.ActionCreate); err != nil {
-		logger.Error("failed send create rule event", zap.Error(err))
-	}
+	variants := r.Variants
+	r.Variants = nil
 
-	for _, v := range variants {
-		addedV, err := p.AddVariant(ctx, r.Id, v)
+	err = p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if err := p.checkRuleQuota(ctx, r.Service); err != nil {
+			return err
+		}
+		if err := p.checkVariantQuota(ctx, r.Service, len(variants)); err != nil {
+			return err
+		}
+
+		created, err := p.storage.CreateRule(ctx, r)
 		if err != nil {
-			return r, err
+			if errors.Is(err, storage.ErrAlreadyExists) {
+				return fmt.Errorf("%w: service %s context %s", ErrDefaultRuleExists, r.Service, r.Context)
+			}
+			return err
+		}
+		r = created
+
+		for _, v := range variants {
+			if v.StartAt != nil && v.StartAt.After(time.Now().UTC()) {
+				v.State = model.StateTypeDisable
+			}
+			added, err := p.storage.AddVariant(ctx, r.Id, v)
+			if err != nil {
+				return errors.Wrapf(err, "add variant %s", v.Name)
+			}
+			r.Variants = append(r.Variants, added)
 		}
-		r.Variants = append(r.Variants, addedV)
+
+		// outbox events become visible to the relay only after commit
+		if err := p.notifier.SendRule(ctx, r.Id, notifier.ActionCreate); err != nil {
+			return errors.Wrap(err, "send create rule event")
+		}
+		for _, v := range r.Variants {
+			var err error
+			if len(v.CloneOf) > 0 {
+				err = p.notifier.SendVariantClone(ctx, r.Id, v.Id, v.CloneOf)
+			} else {
+				err = p.notifier.SendVariant(ctx, r.Id, v.Id, notifier.ActionCreate)
+			}
+			if err != nil {
+				return errors.Wrap(err, "send create variant event")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.Rule{}, err
 	}
 
+	p.recordRevision(ctx, r.Id, "create rule")
+
 	return r, nil
 }
 
@@ -119,11 +327,24 @@ func (p *Provider) UpdateRule(ctx context.Context, r model.Rule) (model.Rule, er
 	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/UpdateRule")
 	defer span.Finish()
 
+	if err := p.authorizeRule(ctx, r.Id); err != nil {
+		return model.Rule{}, err
+	}
+
+	if _, err := p.storage.GetRuleServiceContext(ctx, r.Id); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.Rule{}, ErrNotFound
+		}
+		return model.Rule{}, err
+	}
+
 	r, err := p.storage.UpdateRule(ctx, r)
 	if err != nil {
 		return model.Rule{}, err
 	}
 
+	p.recordRevision(ctx, r.Id, "update rule")
+
 	return r, nil
 }
 
@@ -131,28 +352,401 @@ func (p *Provider) SetRuleState(ctx context.Context, id string, state model.Stat
 	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/SetRuleState")
 	defer span.Finish()
 
-	if err := p.storage.SetRuleState(ctx, id, state); err != nil {
+	if err := p.authorizeRule(ctx, id); err != nil {
+		return err
+	}
+
+	r, err := p.storage.GetRule(ctx, id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if r.Protected {
+		if state == model.StateTypeEnable && len(r.Targeting) == 0 {
+			if err := p.checkDefaultRule(ctx, r); err != nil {
+				return err
+			}
+		}
+		return p.requestChange(ctx, model.ChangeOperationSetRuleState, id, r.Service, ruleStatePayload{State: state})
+	}
+
+	return p.applyRuleState(ctx, id, state)
+}
+
+func (p *Provider) SetScheduledRuleState(ctx context.Context, id string, state model.StateType) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/SetScheduledRuleState")
+	defer span.Finish()
+
+	r, err := p.storage.GetRule(ctx, id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	// nobody is there to submit a scheduled change, so it goes straight to review
+	if r.Protected {
+		cr, err := p.createChangeRequest(ctx, model.ChangeOperationSetRuleState, id, r.Service,
+			ruleStatePayload{State: state}, schedulerActor, model.ChangeStatusPendingReview)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("%w: change request %s", ErrApprovalRequired, cr.Id)
+	}
+
+	return p.applyRuleState(ctx, id, state)
+}
+
+func (p *Provider) applyRuleState(ctx context.Context, id string, state model.StateType) error {
+	if err := p.setRuleState(ctx, id, state); err != nil {
+		return err
+	}
+
+	p.recordRevision(ctx, id, fmt.Sprintf("set rule state %s", state))
+
+	return nil
+}
+
+func (p *Provider) setRuleState(ctx context.Context, id string, state model.StateType) error {
+	if state == model.StateTypeEnable {
+		r, err := p.storage.GetRule(ctx, id)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		if len(r.Targeting) == 0 {
+			if err := p.checkDefaultRule(ctx, r); err != nil {
+				return err
+			}
+		}
+	}
+
+	return p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if err := p.storage.SetRuleState(ctx, id, state); err != nil {
+			if errors.Is(err, storage.ErrAlreadyExists) {
+				return fmt.Errorf("%w: rule %s", ErrDefaultRuleExists, id)
+			}
+			return err
+		}
+
+		switch state {
+		case model.StateTypeDisable:
+			return errors.Wrap(p.notifier.SendRule(ctx, id, notifier.ActionInactive), "send inactive rule event")
+		case model.StateTypeEnable:
+			return errors.Wrap(p.notifier.SendRule(ctx, id, notifier.ActionActive), "send active rule event")
+		}
+
+		return nil
+	})
+}
+
+func (p *Provider) DeleteRule(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/DeleteRule")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, id); err != nil {
+		return err
+	}
+
+	if _, err := p.storage.GetRule(ctx, id); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	return p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if err := p.storage.DeleteRule(ctx, id); err != nil {
+			return err
+		}
+		return errors.Wrap(p.notifier.SendRule(ctx, id, notifier.ActionDelete), "send delete rule event")
+	})
+}
+
+func (p *Provider) SetRuleSchedule(ctx context.Context, id string, startAt, endAt *time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/SetRuleSchedule")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, id); err != nil {
+		return err
+	}
+
+	r, err := p.storage.GetRule(ctx, id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	// the scheduler only activates disabled rules, an enabled one would ignore the start.
+	// A protected rule is disabled through a change request, the schedule is kept.
+	disable := r.State == model.StateTypeEnable && startAt != nil && startAt.After(time.Now().UTC())
+
+	var approval error
+	err = p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if err := p.storage.SetRuleSchedule(ctx, id, startAt, endAt); err != nil {
+			return err
+		}
+		if !disable {
+			return nil
+		}
+		if r.Protected {
+			approval = p.requestChange(ctx, model.ChangeOperationSetRuleState, id, r.Service, ruleStatePayload{State: model.StateTypeDisable})
+			if errors.Is(approval, ErrApprovalRequired) {
+				return nil
+			}
+			return approval
+		}
+		return p.setRuleState(ctx, id, model.StateTypeDisable)
+	})
+	if err != nil {
+		return err
+	}
+
+	p.recordRevision(ctx, id, "set rule schedule")
+
+	return approval
+}
+
+func (p *Provider) SetRuleVariantSchema(ctx context.Context, id, variantSchema string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/SetRuleVariantSchema")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, id); err != nil {
+		return err
+	}
+
+	if _, err := p.storage.GetRule(ctx, id); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if len(variantSchema) > 0 {
+		s, err := schema.Compile(variantSchema)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidSchema, err)
+		}
+
+		variants, err := p.storage.GetVariants(ctx, id)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+
+		for _, v := range variants {
+			if err := schema.Validate(s, v.Data); err != nil {
+				return fmt.Errorf("%w: variant[%s]: %s", ErrIncompatibleSchema, v.Id, err)
+			}
+		}
+	}
+
+	if err := p.storage.SetRuleVariantSchema(ctx, id, variantSchema); err != nil {
+		return err
+	}
+
+	p.recordRevision(ctx, id, "set variant schema")
+
+	return nil
+}
+
+// SetRuleBanditConfig replaces the bandit config of the rule, an empty config resets it
+// to the default config of the bandit key. The indexer applies it to new and reset arms.
+func (p *Provider) SetRuleBanditConfig(ctx context.Context, id, banditConfig string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/SetRuleBanditConfig")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, id); err != nil {
+		return err
+	}
+
+	r, err := p.storage.GetRule(ctx, id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if err := p.setRuleBanditConfig(ctx, r, banditConfig); err != nil {
+		return err
+	}
+
+	p.recordRevision(ctx, id, "set bandit config")
+
+	return nil
+}
+
+func (p *Provider) setRuleBanditConfig(ctx context.Context, r model.Rule, banditConfig string) error {
+	config, err := p.banditConfig(ctx, r.BanditKey, banditConfig)
+	if err != nil {
+		return err
+	}
+
+	return p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if err := p.storage.SetRuleBanditConfig(ctx, r.Id, config); err != nil {
+			return err
+		}
+		return errors.Wrap(p.notifier.SendRule(ctx, r.Id, notifier.ActionUpdate), "send update rule event")
+	})
+}
+
+func validateVariantData(variantSchema, data string) error {
+	if len(variantSchema) == 0 {
+		return nil
+	}
+
+	s, err := schema.Compile(variantSchema)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSchema, err)
+	}
+
+	if err := schema.Validate(s, data); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidVariantData, err)
+	}
+
+	return nil
+}
+
+func (p *Provider) SetRuleTargeting(ctx context.Context, id string, priority int32, targeting []model.TargetingCondition) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/SetRuleTargeting")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, id); err != nil {
+		return err
+	}
+
+	r, err := p.storage.GetRule(ctx, id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if len(targeting) == 0 && r.State == model.StateTypeEnable {
+		if err := p.checkDefaultRule(ctx, r); err != nil {
+			return err
+		}
+	}
+
+	err = p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if err := p.storage.SetRuleTargeting(ctx, id, priority, targeting); err != nil {
+			if errors.Is(err, storage.ErrAlreadyExists) {
+				return fmt.Errorf("%w: rule %s", ErrDefaultRuleExists, id)
+			}
+			return err
+		}
+		return errors.Wrap(p.notifier.SendRule(ctx, id, notifier.ActionUpdate), "send update rule event")
+	})
+	if err != nil {
+		return err
+	}
+
+	p.recordRevision(ctx, id, "set rule targeting")
+
+	return nil
+}
+
+func (p *Provider) checkDefaultRule(ctx context.Context, r model.Rule) error {
+	id, err := p.storage.GetActiveRuleByServiceContext(ctx, r.Service, r.Context)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return err
+	}
+	if len(id) > 0 && id != r.Id {
+		return fmt.Errorf("%w[%s]", ErrDefaultRuleExists, id)
+	}
+	return nil
+}
+
+func (p *Provider) SetRuleLayer(ctx context.Context, id, layerID string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/SetRuleLayer")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, id); err != nil {
 		return err
 	}
 
-	switch state {
-	case model.StateTypeDisable:
-		if err := p.notifier.SendRule(ctx, id, notifier.ActionInactive); err != nil {
-			logger.Error("failed send inactive rule event", zap.Error(err))
+	if _, err := p.storage.GetRuleServiceContext(ctx, id); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
 		}
-	case model.StateTypeEnable:
-		if err := p.notifier.SendRule(ctx, id, notifier.ActionActive); err != nil {
-			logger.Error("failed send active rule event", zap.Error(err))
+		return err
+	}
+
+	if len(layerID) > 0 {
+		layer, err := p.storage.GetLayer(ctx, layerID)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
 		}
+		if err := p.authorizeLayer(ctx, layer); err != nil {
+			return err
+		}
+	}
+
+	err := p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if err := p.storage.SetRuleLayer(ctx, id, layerID); err != nil {
+			return err
+		}
+		return errors.Wrap(p.notifier.SendRule(ctx, id, notifier.ActionUpdate), "send update rule event")
+	})
+	if err != nil {
+		return err
 	}
 
+	p.recordRevision(ctx, id, "set rule layer")
+
 	return nil
 }
 
+func (p *Provider) CreateLayer(ctx context.Context, layer model.Layer) (model.Layer, error) {
+	layer.Tenant = callerTenant(ctx)
+	return p.storage.CreateLayer(ctx, layer)
+}
+
+func (p *Provider) GetLayer(ctx context.Context, id string) (model.Layer, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/GetLayer")
+	defer span.Finish()
+
+	layer, err := p.storage.GetLayer(ctx, id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.Layer{}, ErrNotFound
+		}
+		return model.Layer{}, err
+	}
+
+	if err := p.authorizeLayer(ctx, layer); err != nil {
+		return model.Layer{}, err
+	}
+
+	layer.ActiveRuleIds, err = p.storage.GetLayerActiveRules(ctx, id)
+	if err != nil {
+		return model.Layer{}, err
+	}
+
+	return layer, nil
+}
+
 func (p *Provider) GetVariant(ctx context.Context, ruleID, variandID string) (model.Variant, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/GetVariant")
 	defer span.Finish()
 
+	if err := p.authorizeRule(ctx, ruleID); err != nil {
+		return model.Variant{}, err
+	}
+
 	v, err := p.storage.GetVariant(ctx, ruleID, variandID)
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
@@ -168,53 +762,308 @@ func (p *Provider) AddVariant(ctx context.Context, ruleID string, v model.Varian
 	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/AddVariant")
 	defer span.Finish()
 
-	_, err := p.storage.GetRule(ctx, ruleID)
+	if err := p.authorizeRule(ctx, ruleID); err != nil {
+		return model.Variant{}, err
+	}
+
+	r, err := p.validateVariant(ctx, ruleID, v)
 	if err != nil {
 		return model.Variant{}, err
 	}
 
-	v, err = p.storage.AddVariant(ctx, ruleID, v)
+	if r.Protected {
+		return model.Variant{}, p.requestChange(ctx, model.ChangeOperationAddVariant, ruleID, r.Service, v)
+	}
+
+	return p.applyAddVariant(ctx, ruleID, v)
+}
+
+func (p *Provider) validateVariant(ctx context.Context, ruleID string, v model.Variant) (model.Rule, error) {
+	r, err := p.storage.GetRule(ctx, ruleID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.Rule{}, ErrNotFound
+		}
+		return model.Rule{}, err
+	}
+
+	if err := validateVariantData(r.VariantSchema, v.Data); err != nil {
+		return model.Rule{}, err
+	}
+
+	return r, nil
+}
+
+func (p *Provider) applyAddVariant(ctx context.Context, ruleID string, v model.Variant) (model.Variant, error) {
+	v, err := p.addVariant(ctx, ruleID, v)
 	if err != nil {
 		return model.Variant{}, err
 	}
 
-	if err := p.notifier.SendVariant(ctx, ruleID, v.Id, notifier.ActionCreate); err != nil {
-		logger.Error("failed send create variant event", zap.Error(err))
+	p.recordRevision(ctx, ruleID, "add variant")
+
+	return v, nil
+}
+
+func (p *Provider) addVariant(ctx context.Context, ruleID string, v model.Variant) (model.Variant, error) {
+	if v.StartAt != nil && v.StartAt.After(time.Now().UTC()) {
+		v.State = model.StateTypeDisable
+	}
+
+	err := p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		r, err := p.storage.GetRuleServiceContext(ctx, ruleID)
+		if err != nil {
+			return err
+		}
+		if err := p.checkVariantQuota(ctx, r.Service, 1); err != nil {
+			return err
+		}
+
+		if v, err = p.storage.AddVariant(ctx, ruleID, v); err != nil {
+			return err
+		}
+		return errors.Wrap(p.notifier.SendVariant(ctx, ruleID, v.Id, notifier.ActionCreate), "send create variant event")
+	})
+	if err != nil {
+		return model.Variant{}, err
 	}
 
 	return v, nil
 }
 
+func (p *Provider) UpdateVariant(ctx context.Context, ruleID string, v model.Variant) (model.Variant, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/UpdateVariant")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, ruleID); err != nil {
+		return model.Variant{}, err
+	}
+
+	r, err := p.storage.GetRule(ctx, ruleID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.Variant{}, ErrNotFound
+		}
+		return model.Variant{}, err
+	}
+
+	current, err := p.storage.GetVariant(ctx, ruleID, v.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.Variant{}, ErrNotFound
+		}
+		return model.Variant{}, err
+	}
+
+	if len(v.Name) > 0 {
+		current.Name = v.Name
+	}
+	if len(v.Data) > 0 {
+		current.Data = v.Data
+	}
+
+	if err := validateVariantData(r.VariantSchema, current.Data); err != nil {
+		return model.Variant{}, err
+	}
+
+	err = p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		if current, err = p.storage.UpdateVariant(ctx, current); err != nil {
+			return err
+		}
+		return errors.Wrap(p.notifier.SendVariant(ctx, ruleID, v.Id, notifier.ActionUpdate), "send update variant event")
+	})
+	if err != nil {
+		return model.Variant{}, err
+	}
+
+	p.recordRevision(ctx, ruleID, "update variant")
+
+	return current, nil
+}
+
+func (p *Provider) DeleteVariant(ctx context.Context, ruleID, variantID string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/DeleteVariant")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, ruleID); err != nil {
+		return err
+	}
+
+	if _, err := p.storage.GetVariant(ctx, ruleID, variantID); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if err := p.deleteVariant(ctx, ruleID, variantID); err != nil {
+		return err
+	}
+
+	p.recordRevision(ctx, ruleID, "delete variant")
+
+	return nil
+}
+
+func (p *Provider) deleteVariant(ctx context.Context, ruleID, variantID string) error {
+	return p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if err := p.storage.DeleteVariant(ctx, variantID); err != nil {
+			return err
+		}
+		return errors.Wrap(p.notifier.SendVariant(ctx, ruleID, variantID, notifier.ActionDelete), "send delete variant event")
+	})
+}
+
 func (p *Provider) SetVariantState(ctx context.Context, ruleID, variantID string, state model.StateType) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/SetVariantState")
 	defer span.Finish()
 
-	if err := p.storage.SetVariantState(ctx, variantID, state); err != nil {
+	if err := p.authorizeRule(ctx, ruleID); err != nil {
+		return err
+	}
+
+	if _, err := p.storage.GetVariant(ctx, ruleID, variantID); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if err := p.setVariantState(ctx, ruleID, variantID, state); err != nil {
+		return err
+	}
+
+	p.recordRevision(ctx, ruleID, fmt.Sprintf("set variant state %s", state))
+
+	return nil
+}
+
+func (p *Provider) setVariantState(ctx context.Context, ruleID, variantID string, state model.StateType) error {
+	return p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if err := p.storage.SetVariantState(ctx, variantID, state); err != nil {
+			return err
+		}
+
+		switch state {
+		case model.StateTypeDisable:
+			return errors.Wrap(p.notifier.SendVariant(ctx, ruleID, variantID, notifier.ActionInactive), "send inactive variant event")
+		case model.StateTypeEnable:
+			return errors.Wrap(p.notifier.SendVariant(ctx, ruleID, variantID, notifier.ActionActive), "send active variant event")
+		}
+
+		return nil
+	})
+}
+
+func (p *Provider) SetVariantSchedule(ctx context.Context, ruleID, variantID string, startAt, endAt *time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/SetVariantSchedule")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, ruleID); err != nil {
+		return err
+	}
+
+	v, err := p.storage.GetVariant(ctx, ruleID, variantID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
 		return err
 	}
 
-	switch state {
-	case model.StateTypeDisable:
-		if err := p.notifier.SendVariant(ctx, ruleID, variantID, notifier.ActionInactive); err != nil {
-			logger.Error("failed send inactive variant event", zap.Error(err))
+	err = p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if err := p.storage.SetVariantSchedule(ctx, variantID, startAt, endAt); err != nil {
+			return err
 		}
-	case model.StateTypeEnable:
-		if err := p.notifier.SendVariant(ctx, ruleID, variantID, notifier.ActionActive); err != nil {
-			logger.Error("failed send active variant event", zap.Error(err))
+		if v.State == model.StateTypeEnable && startAt != nil && startAt.After(time.Now().UTC()) {
+			return p.setVariantState(ctx, ruleID, variantID, model.StateTypeDisable)
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
+	p.recordRevision(ctx, ruleID, "set variant schedule")
+
 	return nil
 }
 
-func (p *Provider) CreateWantedBandit(ctx context.Context, wb model.WantedBandit) error {
-	return p.storage.CreateWantedBandit(ctx, wb)
+func (p *Provider) AddGuardrail(ctx context.Context, g model.Guardrail) (model.Guardrail, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/AddGuardrail")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, g.RuleId); err != nil {
+		return model.Guardrail{}, err
+	}
+
+	if _, err := p.storage.GetRule(ctx, g.RuleId); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.Guardrail{}, ErrNotFound
+		}
+		return model.Guardrail{}, err
+	}
+
+	return p.storage.AddGuardrail(ctx, g)
 }
 
-func (p *Provider) GetWantedRegistry(ctx context.Context) ([]model.WantedBandit, error) {
-	return p.storage.GetWantedRegistry(ctx)
+func (p *Provider) RemoveGuardrail(ctx context.Context, id string) error {
+	g, err := p.storage.GetGuardrail(ctx, id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if err := p.authorizeRule(ctx, g.RuleId); err != nil {
+		return err
+	}
+
+	return p.storage.DeleteGuardrail(ctx, id)
+}
+
+func (p *Provider) GetRuleGuardrails(ctx context.Context, ruleID string) ([]model.Guardrail, error) {
+	if err := p.authorizeRule(ctx, ruleID); err != nil {
+		return nil, err
+	}
+
+	return p.storage.GetGuardrails(ctx, ruleID)
+}
+
+func (p *Provider) ListGuardrails(ctx context.Context) ([]model.Guardrail, error) {
+	services, err := p.scopedServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.storage.GetActiveGuardrails(ctx, services)
+}
+
+func (p *Provider) AddAuditEvent(ctx context.Context, e model.AuditEvent) error {
+	return p.storage.AddAuditEvent(ctx, e)
+}
+
+func (p *Provider) ListAuditEvents(ctx context.Context, filter model.AuditFilter) ([]model.AuditEvent, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ListAuditEvents")
+	defer span.Finish()
+
+	services, err := p.scopedServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if services != nil {
+		filter.Services = services
+	}
+
+	return p.storage.ListAuditEvents(ctx, filter)
 }
 
-func (p *Provider) GetRuleServiceContext(ctx context.Context, ruleID string) (string, string, error) {
+func (p *Provider) GetRuleServiceContext(ctx context.Context, ruleID string) (model.Rule, error) {
+	if err := p.authorizeRule(ctx, ruleID); err != nil {
+		return model.Rule{}, err
+	}
+
 	return p.storage.GetRuleServiceContext(ctx, ruleID)
 }
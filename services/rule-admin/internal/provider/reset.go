@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/notifier"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/storage"
+)
+
+type resetStatsPayload struct {
+	VariantID string `json:"variant_id,omitempty"`
+}
+
+// ResetStatistics asks the indexer to wipe learned statistics of the variant,
+// of every rule variant for empty variantID. Variants themselves are kept.
+func (p *Provider) ResetStatistics(ctx context.Context, ruleID, variantID string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ResetStatistics")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, ruleID); err != nil {
+		return err
+	}
+
+	r, err := p.storage.GetRule(ctx, ruleID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if len(variantID) > 0 {
+		if _, err := p.storage.GetVariant(ctx, ruleID, variantID); err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+	}
+
+	if r.Protected {
+		return p.requestChange(ctx, model.ChangeOperationResetStats, ruleID, r.Service, resetStatsPayload{VariantID: variantID})
+	}
+
+	return p.applyResetStatistics(ctx, ruleID, variantID)
+}
+
+func (p *Provider) applyResetStatistics(ctx context.Context, ruleID, variantID string) error {
+	err := p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if len(variantID) == 0 {
+			return errors.Wrap(p.notifier.SendRule(ctx, ruleID, notifier.ActionReset), "send reset rule event")
+		}
+		return errors.Wrap(p.notifier.SendVariant(ctx, ruleID, variantID, notifier.ActionReset), "send reset variant event")
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(variantID) == 0 {
+		p.recordRevision(ctx, ruleID, "reset statistics")
+	} else {
+		p.recordRevision(ctx, ruleID, fmt.Sprintf("reset variant %s statistics", variantID))
+	}
+
+	return nil
+}
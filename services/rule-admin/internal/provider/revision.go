@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/EbumbaE/bandit/pkg/logger"
+	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/notifier"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/revision"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/storage"
+)
+
+func (p *Provider) recordRevision(ctx context.Context, ruleID, reason string) {
+	r, err := p.storage.GetRule(ctx, ruleID)
+	if err != nil {
+		logger.Error("record revision: get rule", zap.String("rule_id", ruleID), zap.Error(err))
+		return
+	}
+
+	r.Variants, err = p.storage.GetVariants(ctx, ruleID)
+	if err != nil {
+		logger.Error("record revision: get variants", zap.String("rule_id", ruleID), zap.Error(err))
+		return
+	}
+
+	snapshot, err := json.Marshal(r)
+	if err != nil {
+		logger.Error("record revision: marshal snapshot", zap.String("rule_id", ruleID), zap.Error(err))
+		return
+	}
+
+	if _, err := p.storage.AddRuleRevision(ctx, ruleID, reason, snapshot); err != nil {
+		logger.Error("record revision: save", zap.String("rule_id", ruleID), zap.Error(err))
+	}
+}
+
+func (p *Provider) ListRuleRevisions(ctx context.Context, ruleID string) ([]model.RuleRevision, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ListRuleRevisions")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, ruleID); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.storage.GetRuleServiceContext(ctx, ruleID); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return p.storage.GetRuleRevisions(ctx, ruleID)
+}
+
+func (p *Provider) GetRuleRevision(ctx context.Context, ruleID string, rev uint64) (model.RuleRevision, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/GetRuleRevision")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, ruleID); err != nil {
+		return model.RuleRevision{}, err
+	}
+
+	r, err := p.storage.GetRuleRevision(ctx, ruleID, rev)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.RuleRevision{}, ErrNotFound
+		}
+		return model.RuleRevision{}, err
+	}
+
+	return r, nil
+}
+
+func (p *Provider) DiffRuleRevisions(ctx context.Context, ruleID string, fromRev, toRev uint64) ([]model.RevisionChange, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/DiffRuleRevisions")
+	defer span.Finish()
+
+	from, err := p.GetRuleRevision(ctx, ruleID, fromRev)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := p.GetRuleRevision(ctx, ruleID, toRev)
+	if err != nil {
+		return nil, err
+	}
+
+	return revision.Diff(from.Rule, to.Rule)
+}
+
+type ruleRollbackPayload struct {
+	Revision uint64 `json:"revision"`
+}
+
+func (p *Provider) RollbackRule(ctx context.Context, ruleID string, rev uint64) (model.Rule, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/RollbackRule")
+	defer span.Finish()
+
+	if err := p.authorizeRule(ctx, ruleID); err != nil {
+		return model.Rule{}, err
+	}
+
+	if _, err := p.GetRuleRevision(ctx, ruleID, rev); err != nil {
+		return model.Rule{}, err
+	}
+
+	current, err := p.GetRule(ctx, ruleID)
+	if err != nil {
+		return model.Rule{}, err
+	}
+
+	if current.Protected {
+		return model.Rule{}, p.requestChange(ctx, model.ChangeOperationRollbackRule, ruleID, current.Service, ruleRollbackPayload{Revision: rev})
+	}
+
+	return p.applyRollback(ctx, ruleID, rev)
+}
+
+func (p *Provider) applyRollback(ctx context.Context, ruleID string, rev uint64) (model.Rule, error) {
+	target, err := p.GetRuleRevision(ctx, ruleID, rev)
+	if err != nil {
+		return model.Rule{}, err
+	}
+	snapshot := target.Rule
+
+	current, err := p.GetRule(ctx, ruleID)
+	if err != nil {
+		return model.Rule{}, err
+	}
+
+	if len(snapshot.LayerId) > 0 {
+		layer, err := p.storage.GetLayer(ctx, snapshot.LayerId)
+		if err != nil {
+			return model.Rule{}, errors.Wrapf(err, "get layer %s", snapshot.LayerId)
+		}
+		if err := p.authorizeLayer(ctx, layer); err != nil {
+			return model.Rule{}, err
+		}
+	}
+
+	err = p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if err := p.checkVariantQuota(ctx, current.Service, countMissing(current.Variants, snapshot.Variants)); err != nil {
+			return err
+		}
+
+		if _, err := p.storage.UpdateRule(ctx, snapshot); err != nil {
+			return err
+		}
+		if err := p.storage.SetRuleSchedule(ctx, ruleID, snapshot.StartAt, snapshot.EndAt); err != nil {
+			return err
+		}
+		if err := p.storage.SetRuleTargeting(ctx, ruleID, snapshot.Priority, snapshot.Targeting); err != nil {
+			return err
+		}
+		if err := p.storage.SetRuleVariantSchema(ctx, ruleID, snapshot.VariantSchema); err != nil {
+			return err
+		}
+		if err := p.storage.SetRuleLayer(ctx, ruleID, snapshot.LayerId); err != nil {
+			return err
+		}
+		// rollback keeps the bandit key, a config of another key doesn't fit its schema
+		if len(snapshot.BanditConfig) > 0 && snapshot.BanditKey == current.BanditKey {
+			if err := p.storage.SetRuleBanditConfig(ctx, ruleID, snapshot.BanditConfig); err != nil {
+				return err
+			}
+		}
+
+		if err := p.notifier.SendRule(ctx, ruleID, notifier.ActionUpdate); err != nil {
+			return errors.Wrap(err, "send update rule event")
+		}
+
+		if current.State != snapshot.State {
+			if err := p.setRuleState(ctx, ruleID, snapshot.State); err != nil {
+				return err
+			}
+		}
+
+		return p.rollbackVariants(ctx, ruleID, current.Variants, snapshot.Variants)
+	})
+	if err != nil {
+		return model.Rule{}, err
+	}
+
+	p.recordRevision(ctx, ruleID, fmt.Sprintf("rollback to revision %d", rev))
+
+	return p.GetRule(ctx, ruleID)
+}
+
+func (p *Provider) rollbackVariants(ctx context.Context, ruleID string, current, target []model.Variant) error {
+	currentByID := make(map[string]model.Variant, len(current))
+	for _, v := range current {
+		currentByID[v.Id] = v
+	}
+
+	targetIDs := make(map[string]struct{}, len(target))
+	for _, v := range target {
+		targetIDs[v.Id] = struct{}{}
+
+		cur, ok := currentByID[v.Id]
+		if !ok {
+			if err := p.restoreVariant(ctx, ruleID, v); err != nil {
+				return errors.Wrapf(err, "restore variant %s", v.Id)
+			}
+			continue
+		}
+
+		if cur.Name != v.Name || cur.Data != v.Data {
+			if _, err := p.storage.UpdateVariant(ctx, v); err != nil {
+				return errors.Wrapf(err, "update variant %s", v.Id)
+			}
+			if err := p.notifier.SendVariant(ctx, ruleID, v.Id, notifier.ActionUpdate); err != nil {
+				return errors.Wrap(err, "send update variant event")
+			}
+		}
+
+		if err := p.storage.SetVariantSchedule(ctx, v.Id, v.StartAt, v.EndAt); err != nil {
+			return errors.Wrapf(err, "set variant %s schedule", v.Id)
+		}
+
+		if cur.State != v.State {
+			if err := p.setVariantState(ctx, ruleID, v.Id, v.State); err != nil {
+				return errors.Wrapf(err, "set variant %s state", v.Id)
+			}
+		}
+	}
+
+	for _, v := range current {
+		if _, ok := targetIDs[v.Id]; ok {
+			continue
+		}
+		if err := p.deleteVariant(ctx, ruleID, v.Id); err != nil {
+			return errors.Wrapf(err, "delete variant %s", v.Id)
+		}
+	}
+
+	return nil
+}
+
+// restoreVariant brings a deleted variant back under its id, the indexer revives
+// the arm with its statistics on the create event.
+func (p *Provider) restoreVariant(ctx context.Context, ruleID string, v model.Variant) error {
+	err := p.storage.RestoreVariant(ctx, ruleID, v)
+	if errors.Is(err, storage.ErrNotFound) {
+		logger.Error("restore variant: no deleted row, adding a new one", zap.String("variant_id", v.Id))
+		_, err = p.addVariant(ctx, ruleID, v)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(p.notifier.SendVariant(ctx, ruleID, v.Id, notifier.ActionCreate), "send create variant event")
+}
+
+func countMissing(current, target []model.Variant) int {
+	ids := make(map[string]struct{}, len(current))
+	for _, v := range current {
+		ids[v.Id] = struct{}{}
+	}
+
+	missing := 0
+	for _, v := range target {
+		if _, ok := ids[v.Id]; !ok {
+			missing++
+		}
+	}
+	return missing
+}
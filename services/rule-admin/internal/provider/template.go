@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/schema"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/storage"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/template"
+)
+
+var ErrInvalidTemplate = errors.New("invalid template")
+
+func (p *Provider) CloneRule(ctx context.Context, ruleID string, target model.CloneTarget) (model.Rule, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/CloneRule")
+	defer span.Finish()
+
+	src, err := p.GetRule(ctx, ruleID)
+	if err != nil {
+		return model.Rule{}, err
+	}
+
+	clone := model.Rule{
+		Name:          target.Name,
+		Description:   src.Description,
+		State:         target.State,
+		BanditKey:     src.BanditKey,
+		Service:       target.Service,
+		Context:       target.Context,
+		Priority:      src.Priority,
+		Targeting:     src.Targeting,
+		VariantSchema: src.VariantSchema,
+		BanditConfig:  src.BanditConfig,
+	}
+	if len(clone.Name) == 0 {
+		clone.Name = src.Name
+	}
+
+	for _, v := range src.Variants {
+		cv := model.Variant{
+			Name:  v.Name,
+			Data:  v.Data,
+			State: v.State,
+		}
+		if target.CarryStatistics {
+			cv.CloneOf = v.Id
+		}
+		clone.Variants = append(clone.Variants, cv)
+	}
+
+	return p.CreateRule(ctx, clone)
+}
+
+func (p *Provider) CreateRuleTemplate(ctx context.Context, t model.RuleTemplate) (model.RuleTemplate, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/CreateRuleTemplate")
+	defer span.Finish()
+
+	t.Tenant = callerTenant(ctx)
+
+	if err := p.checkBanditKey(ctx, t.BanditKey); err != nil {
+		return model.RuleTemplate{}, err
+	}
+
+	if len(t.VariantSchema) > 0 {
+		if _, err := schema.Compile(t.VariantSchema); err != nil {
+			return model.RuleTemplate{}, fmt.Errorf("%w: %s", ErrInvalidSchema, err)
+		}
+	}
+
+	for _, v := range t.Variants {
+		if _, err := template.Params(v.Data); err != nil {
+			return model.RuleTemplate{}, fmt.Errorf("%w: variant %s: %s", ErrInvalidTemplate, v.Name, err)
+		}
+	}
+
+	if err := p.storage.CreateRuleTemplate(ctx, t); err != nil {
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			return model.RuleTemplate{}, ErrAlreadyExists
+		}
+		return model.RuleTemplate{}, err
+	}
+
+	return p.GetRuleTemplate(ctx, t.Name)
+}
+
+func (p *Provider) GetRuleTemplate(ctx context.Context, name string) (model.RuleTemplate, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/GetRuleTemplate")
+	defer span.Finish()
+
+	t, err := p.storage.GetRuleTemplate(ctx, name)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.RuleTemplate{}, ErrNotFound
+		}
+		return model.RuleTemplate{}, err
+	}
+
+	// global templates are shared with every tenant
+	if caller := callerTenant(ctx); len(caller) > 0 && len(t.Tenant) > 0 && t.Tenant != caller {
+		return model.RuleTemplate{}, ErrNotFound
+	}
+
+	return withTemplateParams(t), nil
+}
+
+func (p *Provider) ListRuleTemplates(ctx context.Context) ([]model.RuleTemplate, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ListRuleTemplates")
+	defer span.Finish()
+
+	var tenants []string
+	if caller := callerTenant(ctx); len(caller) > 0 {
+		tenants = []string{"", caller}
+	}
+
+	templates, err := p.storage.ListRuleTemplates(ctx, tenants)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range templates {
+		templates[i] = withTemplateParams(templates[i])
+	}
+
+	return templates, nil
+}
+
+func (p *Provider) DeleteRuleTemplate(ctx context.Context, name string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/DeleteRuleTemplate")
+	defer span.Finish()
+
+	t, err := p.GetRuleTemplate(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if caller := callerTenant(ctx); len(caller) > 0 && t.Tenant != caller {
+		return ErrForbidden
+	}
+
+	return p.storage.DeleteRuleTemplate(ctx, name)
+}
+
+func (p *Provider) InstantiateRuleTemplate(ctx context.Context, name string, inst model.TemplateInstance) (model.Rule, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/InstantiateRuleTemplate")
+	defer span.Finish()
+
+	t, err := p.GetRuleTemplate(ctx, name)
+	if err != nil {
+		return model.Rule{}, err
+	}
+
+	r := model.Rule{
+		Name:          inst.Name,
+		Description:   inst.Description,
+		State:         inst.State,
+		BanditKey:     t.BanditKey,
+		Service:       inst.Service,
+		Context:       inst.Context,
+		Priority:      t.Priority,
+		Targeting:     t.Targeting,
+		VariantSchema: t.VariantSchema,
+	}
+	if len(r.Description) == 0 {
+		r.Description = t.Description
+	}
+
+	for _, v := range t.Variants {
+		data, err := template.Render(v.Data, inst.Params)
+		if err != nil {
+			return model.Rule{}, fmt.Errorf("%w: variant %s: %s", ErrInvalidTemplate, v.Name, err)
+		}
+		r.Variants = append(r.Variants, model.Variant{
+			Name:  v.Name,
+			Data:  data,
+			State: model.StateTypeEnable,
+		})
+	}
+
+	return p.CreateRule(ctx, r)
+}
+
+func withTemplateParams(t model.RuleTemplate) model.RuleTemplate {
+	t.Parameters = nil
+	for _, v := range t.Variants {
+		params, _ := template.Params(v.Data)
+		for _, param := range params {
+			if !slices.Contains(t.Parameters, param) {
+				t.Parameters = append(t.Parameters, param)
+			}
+		}
+	}
+	slices.Sort(t.Parameters)
+	return t
+}
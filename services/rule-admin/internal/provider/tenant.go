@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	"github.com/EbumbaE/bandit/pkg/auth"
+	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/storage"
+)
+
+var (
+	ErrForbidden     = errors.New("forbidden for tenant")
+	ErrQuotaExceeded = errors.New("tenant quota exceeded")
+	ErrAlreadyExists = errors.New("already exists")
+)
+
+func callerTenant(ctx context.Context) string {
+	p, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return ""
+	}
+	return p.Tenant
+}
+
+func (p *Provider) scopedServices(ctx context.Context) ([]string, error) {
+	name := callerTenant(ctx)
+	if len(name) == 0 {
+		return nil, nil
+	}
+
+	t, err := p.storage.GetTenant(ctx, name)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrForbidden
+		}
+		return nil, err
+	}
+
+	if t.Services == nil {
+		t.Services = []string{}
+	}
+
+	return t.Services, nil
+}
+
+func (p *Provider) authorizeService(ctx context.Context, service string) error {
+	services, err := p.scopedServices(ctx)
+	if err != nil {
+		return err
+	}
+	if services != nil && !slices.Contains(services, service) {
+		return fmt.Errorf("%w: service %s", ErrForbidden, service)
+	}
+	return nil
+}
+
+func (p *Provider) authorizeRule(ctx context.Context, ruleID string) error {
+	if len(callerTenant(ctx)) == 0 {
+		return nil
+	}
+
+	r, err := p.storage.GetRuleServiceContext(ctx, ruleID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if err := p.authorizeService(ctx, r.Service); err != nil {
+		if errors.Is(err, ErrForbidden) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (p *Provider) authorizeLayer(ctx context.Context, layer model.Layer) error {
+	name := callerTenant(ctx)
+	if len(name) > 0 && layer.Tenant != name {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (p *Provider) requireGlobal(ctx context.Context) error {
+	if len(callerTenant(ctx)) > 0 {
+		return ErrForbidden
+	}
+	return nil
+}
+
+func (p *Provider) serviceTenant(ctx context.Context, service string) (model.Tenant, bool, error) {
+	name, err := p.storage.GetServiceTenant(ctx, service)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.Tenant{}, false, nil
+		}
+		return model.Tenant{}, false, err
+	}
+
+	t, err := p.storage.GetTenant(ctx, name)
+	if err != nil {
+		return model.Tenant{}, false, err
+	}
+
+	return t, true, nil
+}
+
+// checkRuleQuota and checkVariantQuota lock the tenant row, call them in the
+// transaction of the insert so concurrent creates can't pass the same count.
+func (p *Provider) checkRuleQuota(ctx context.Context, service string) error {
+	t, ok, err := p.serviceTenant(ctx, service)
+	if err != nil || !ok || t.MaxRules <= 0 {
+		return err
+	}
+
+	if err := p.storage.LockTenant(ctx, t.Name); err != nil {
+		return errors.Wrap(err, "lock tenant")
+	}
+
+	count, err := p.storage.CountTenantRules(ctx, t.Name)
+	if err != nil {
+		return err
+	}
+	if count >= t.MaxRules {
+		return fmt.Errorf("%w: tenant %s max rules %d", ErrQuotaExceeded, t.Name, t.MaxRules)
+	}
+
+	return nil
+}
+
+func (p *Provider) checkVariantQuota(ctx context.Context, service string, adding int) error {
+	if adding <= 0 {
+		return nil
+	}
+
+	t, ok, err := p.serviceTenant(ctx, service)
+	if err != nil || !ok || t.MaxVariants <= 0 {
+		return err
+	}
+
+	if err := p.storage.LockTenant(ctx, t.Name); err != nil {
+		return errors.Wrap(err, "lock tenant")
+	}
+
+	count, err := p.storage.CountTenantVariants(ctx, t.Name)
+	if err != nil {
+		return err
+	}
+	if count+adding > t.MaxVariants {
+		return fmt.Errorf("%w: tenant %s max variants %d", ErrQuotaExceeded, t.Name, t.MaxVariants)
+	}
+
+	return nil
+}
+
+func (p *Provider) CreateTenant(ctx context.Context, t model.Tenant) (model.Tenant, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/CreateTenant")
+	defer span.Finish()
+
+	if err := p.requireGlobal(ctx); err != nil {
+		return model.Tenant{}, err
+	}
+
+	err := p.storage.RunInTx(ctx, func(ctx context.Context) error {
+		if err := p.storage.CreateTenant(ctx, t); err != nil {
+			if errors.Is(err, storage.ErrAlreadyExists) {
+				return ErrAlreadyExists
+			}
+			return err
+		}
+
+		if len(t.Services) > 0 {
+			return p.setTenantServices(ctx, t.Name, t.Services)
+		}
+		return nil
+	})
+	if err != nil {
+		return model.Tenant{}, err
+	}
+
+	return p.GetTenant(ctx, t.Name)
+}
+
+func (p *Provider) UpdateTenant(ctx context.Context, t model.Tenant) (model.Tenant, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/UpdateTenant")
+	defer span.Finish()
+
+	if err := p.requireGlobal(ctx); err != nil {
+		return model.Tenant{}, err
+	}
+
+	if _, err := p.GetTenant(ctx, t.Name); err != nil {
+		return model.Tenant{}, err
+	}
+
+	if err := p.storage.UpdateTenant(ctx, t); err != nil {
+		return model.Tenant{}, err
+	}
+
+	return p.GetTenant(ctx, t.Name)
+}
+
+func (p *Provider) SetTenantServices(ctx context.Context, name string, services []string) (model.Tenant, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/SetTenantServices")
+	defer span.Finish()
+
+	if err := p.requireGlobal(ctx); err != nil {
+		return model.Tenant{}, err
+	}
+
+	if _, err := p.GetTenant(ctx, name); err != nil {
+		return model.Tenant{}, err
+	}
+
+	if err := p.setTenantServices(ctx, name, services); err != nil {
+		return model.Tenant{}, err
+	}
+
+	return p.GetTenant(ctx, name)
+}
+
+func (p *Provider) setTenantServices(ctx context.Context, name string, services []string) error {
+	if err := p.storage.SetTenantServices(ctx, name, services); err != nil {
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			return fmt.Errorf("%w: service is owned by another tenant", ErrAlreadyExists)
+		}
+		return err
+	}
+	return nil
+}
+
+func (p *Provider) GetTenant(ctx context.Context, name string) (model.Tenant, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/GetTenant")
+	defer span.Finish()
+
+	if caller := callerTenant(ctx); len(caller) > 0 && caller != name {
+		return model.Tenant{}, ErrNotFound
+	}
+
+	t, err := p.storage.GetTenant(ctx, name)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.Tenant{}, ErrNotFound
+		}
+		return model.Tenant{}, err
+	}
+
+	if t.RuleCount, err = p.storage.CountTenantRules(ctx, name); err != nil {
+		return model.Tenant{}, err
+	}
+	if t.VariantCount, err = p.storage.CountTenantVariants(ctx, name); err != nil {
+		return model.Tenant{}, err
+	}
+
+	return t, nil
+}
+
+func (p *Provider) ListTenants(ctx context.Context) ([]model.Tenant, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ListTenants")
+	defer span.Finish()
+
+	if caller := callerTenant(ctx); len(caller) > 0 {
+		t, err := p.GetTenant(ctx, caller)
+		if err != nil {
+			return nil, err
+		}
+		return []model.Tenant{t}, nil
+	}
+
+	return p.storage.ListTenants(ctx)
+}
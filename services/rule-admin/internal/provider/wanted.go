@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+
+	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/schema"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/storage"
+)
+
+var (
+	ErrUnknownBandit       = errors.New("unknown bandit key")
+	ErrDeprecatedBandit    = errors.New("bandit key is deprecated")
+	ErrInvalidBanditConfig = errors.New("invalid bandit config")
+)
+
+func (p *Provider) CreateWantedBandit(ctx context.Context, wb model.WantedBandit) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/CreateWantedBandit")
+	defer span.Finish()
+
+	if err := p.requireGlobal(ctx); err != nil {
+		return err
+	}
+
+	if err := validateWantedBandit(wb); err != nil {
+		return err
+	}
+
+	err := p.storage.CreateWantedBandit(ctx, wb)
+	if errors.Is(err, storage.ErrAlreadyExists) {
+		return ErrAlreadyExists
+	}
+
+	return err
+}
+
+func (p *Provider) GetWantedRegistry(ctx context.Context) ([]model.WantedBandit, error) {
+	return p.storage.GetWantedRegistry(ctx)
+}
+
+func (p *Provider) GetWantedBandit(ctx context.Context, banditKey string) (model.WantedBandit, error) {
+	wb, err := p.storage.GetWantedBandit(ctx, banditKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return model.WantedBandit{}, ErrNotFound
+	}
+
+	return wb, err
+}
+
+// UpdateWantedBandit replaces the name, config schema and default config of a bandit key.
+// Rules already using the key keep their bandit config: the config schema describes new bandits.
+func (p *Provider) UpdateWantedBandit(ctx context.Context, wb model.WantedBandit) (model.WantedBandit, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/UpdateWantedBandit")
+	defer span.Finish()
+
+	if err := p.requireGlobal(ctx); err != nil {
+		return model.WantedBandit{}, err
+	}
+
+	if err := validateWantedBandit(wb); err != nil {
+		return model.WantedBandit{}, err
+	}
+
+	if err := p.storage.UpdateWantedBandit(ctx, wb); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.WantedBandit{}, ErrNotFound
+		}
+		return model.WantedBandit{}, err
+	}
+
+	return p.GetWantedBandit(ctx, wb.BanditKey)
+}
+
+// DeprecateWantedBandit forbids new rules on the bandit key, or allows them again when deprecated is false,
+// and returns rules that still use the key.
+func (p *Provider) DeprecateWantedBandit(ctx context.Context, banditKey string, deprecated bool, reason string) (model.WantedBandit, []model.Rule, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/DeprecateWantedBandit")
+	defer span.Finish()
+
+	if err := p.requireGlobal(ctx); err != nil {
+		return model.WantedBandit{}, nil, err
+	}
+
+	if !deprecated {
+		reason = ""
+	}
+
+	if err := p.storage.SetWantedBanditDeprecated(ctx, banditKey, deprecated, reason); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return model.WantedBandit{}, nil, ErrNotFound
+		}
+		return model.WantedBandit{}, nil, err
+	}
+
+	wb, err := p.GetWantedBandit(ctx, banditKey)
+	if err != nil {
+		return model.WantedBandit{}, nil, err
+	}
+
+	var rules []model.Rule
+	if deprecated {
+		if rules, err = p.listRules(ctx, model.RuleFilter{BanditKey: banditKey}); err != nil {
+			return model.WantedBandit{}, nil, err
+		}
+	}
+
+	return wb, rules, nil
+}
+
+// ListStaleBanditRules reports rules visible to the caller whose bandit key
+// is deprecated or was removed from the registry.
+func (p *Provider) ListStaleBanditRules(ctx context.Context) ([]model.StaleBanditRule, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "provider/ListStaleBanditRules")
+	defer span.Finish()
+
+	services, err := p.scopedServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := p.storage.ListStaleBanditRules(ctx, services)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := p.storage.GetWantedRegistry(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]struct{}, len(registry))
+	for _, wb := range registry {
+		known[wb.BanditKey] = struct{}{}
+	}
+
+	stale := make([]model.StaleBanditRule, 0, len(rules))
+	for _, r := range rules {
+		issue := model.BanditKeyIssueUnknown
+		if _, ok := known[r.BanditKey]; ok {
+			issue = model.BanditKeyIssueDeprecated
+		}
+		stale = append(stale, model.StaleBanditRule{Rule: r, Issue: issue})
+	}
+
+	return stale, nil
+}
+
+// checkBanditKey allows only registered and not deprecated bandit keys for new rules.
+func (p *Provider) checkBanditKey(ctx context.Context, banditKey string) error {
+	wb, err := p.storage.GetWantedBandit(ctx, banditKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("%w: %s", ErrUnknownBandit, banditKey)
+		}
+		return err
+	}
+
+	if wb.Deprecated() {
+		if len(wb.DeprecationReason) > 0 {
+			return fmt.Errorf("%w: %s: %s", ErrDeprecatedBandit, banditKey, wb.DeprecationReason)
+		}
+		return fmt.Errorf("%w: %s", ErrDeprecatedBandit, banditKey)
+	}
+
+	return nil
+}
+
+// banditConfig checks the bandit config of a rule against the config schema of the
+// bandit key, an empty config falls back to the default config of the key.
+func (p *Provider) banditConfig(ctx context.Context, banditKey, config string) (string, error) {
+	wb, err := p.storage.GetWantedBandit(ctx, banditKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return "", fmt.Errorf("%w: %s", ErrUnknownBandit, banditKey)
+		}
+		return "", err
+	}
+
+	if len(config) == 0 {
+		return wb.DefaultConfig, nil
+	}
+
+	if !json.Valid([]byte(config)) {
+		return "", fmt.Errorf("%w: not a valid json", ErrInvalidBanditConfig)
+	}
+
+	if len(wb.ConfigSchema) == 0 {
+		return config, nil
+	}
+
+	s, err := schema.Compile(wb.ConfigSchema)
+	if err != nil {
+		return "", fmt.Errorf("%w: config schema of %s: %s", ErrInvalidBanditConfig, banditKey, err)
+	}
+	if err := schema.Validate(s, config); err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidBanditConfig, err)
+	}
+
+	return config, nil
+}
+
+func validateWantedBandit(wb model.WantedBandit) error {
+	if len(wb.BanditKey) == 0 || len(wb.Name) == 0 {
+		return fmt.Errorf("%w: bandit key and name are required", ErrInvalidBanditConfig)
+	}
+
+	if len(wb.DefaultConfig) > 0 && !json.Valid([]byte(wb.DefaultConfig)) {
+		return fmt.Errorf("%w: default config is not a valid json", ErrInvalidBanditConfig)
+	}
+
+	if len(wb.ConfigSchema) == 0 {
+		return nil
+	}
+
+	s, err := schema.Compile(wb.ConfigSchema)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidBanditConfig, err)
+	}
+
+	if len(wb.DefaultConfig) > 0 {
+		if err := schema.Validate(s, wb.DefaultConfig); err != nil {
+			return fmt.Errorf("%w: default config: %s", ErrInvalidBanditConfig, err)
+		}
+	}
+
+	return nil
+}
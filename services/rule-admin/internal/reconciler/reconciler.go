@@ -0,0 +1,243 @@
+package reconciler
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	desc "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
+	"github.com/EbumbaE/bandit/pkg/logger"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/bundle"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/metrics"
+)
+
+type Importer interface {
+	ImportRules(ctx context.Context, req *desc.ImportRulesRequest, opts ...grpc.CallOption) (*desc.ImportRulesResponse, error)
+}
+
+// Reconciler converges rule-admin to the manifests of a directory. Each service is
+// reconciled separately: the plan is computed with a dry-run import, destructive
+// changes of rules without bandit.io/allow-destructive are held back, the rest is
+// applied. The apply is guarded, so rule-admin rejects it if rules changed since the
+// dry-run and the applied plan got other destructive changes. Rules missing in
+// manifests are disabled only for services of documents annotated with bandit.io/prune.
+type Reconciler struct {
+	importer Importer
+	dir      string
+	interval time.Duration
+	dryRun   bool
+}
+
+func New(importer Importer, dir string, interval time.Duration, dryRun bool) *Reconciler {
+	return &Reconciler{
+		importer: importer,
+		dir:      dir,
+		interval: interval,
+		dryRun:   dryRun,
+	}
+}
+
+func (r *Reconciler) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Reconcile(ctx); err != nil {
+			logger.Error("reconcile rules", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+type serviceManifest struct {
+	rules []bundle.Rule
+	prune bool
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context) error {
+	docs, err := loadManifests(r.dir)
+	if err != nil {
+		return err
+	}
+
+	manifests := make(map[string]*serviceManifest)
+	for _, doc := range docs {
+		prune := doc.Annotations[bundle.AnnotationPrune] == "true"
+		for _, rule := range doc.Rules {
+			m, ok := manifests[rule.Service]
+			if !ok {
+				m = &serviceManifest{}
+				manifests[rule.Service] = m
+			}
+			m.rules = append(m.rules, rule)
+			m.prune = m.prune || prune
+		}
+	}
+
+	services := make([]string, 0, len(manifests))
+	for service := range manifests {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	var failed []string
+	for _, service := range services {
+		if err := r.reconcileService(ctx, service, manifests[service]); err != nil {
+			metrics.ReconcileRuns.WithLabelValues(service, "error").Inc()
+			logger.Error("reconcile service", zap.String("service", service), zap.Error(err))
+			failed = append(failed, service)
+			continue
+		}
+		metrics.ReconcileRuns.WithLabelValues(service, "ok").Inc()
+	}
+
+	if len(failed) > 0 {
+		return errors.Errorf("reconcile failed for services: %s", strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+func (r *Reconciler) reconcileService(ctx context.Context, service string, m *serviceManifest) error {
+	plan, err := r.importRules(ctx, m.rules, true, m.prune, false)
+	if err != nil {
+		return errors.Wrap(err, "plan")
+	}
+
+	reportDrift(service, plan.GetChanges())
+
+	allowed := make(map[string]bool, len(m.rules))
+	for _, rule := range m.rules {
+		allowed[rule.Context+"/"+rule.Name] = rule.Annotations[bundle.AnnotationAllowDestructive] == "true"
+	}
+
+	// pruned rules are absent in manifests, the prune annotation is their permission
+	refused := make(map[string]struct{})
+	for _, c := range plan.GetChanges() {
+		key := c.GetContext() + "/" + c.GetName()
+		isAllowed, inManifest := allowed[key]
+		if !c.GetDestructive() || !inManifest || isAllowed {
+			continue
+		}
+		refused[key] = struct{}{}
+		logger.Info("refuse destructive change without annotation",
+			zap.String("service", service), zap.String("rule", key), zap.Strings("details", c.GetDetails()))
+	}
+	metrics.ReconcileRefused.WithLabelValues(service).Set(float64(len(refused)))
+
+	if r.dryRun || len(plan.GetChanges()) == len(refused) {
+		return nil
+	}
+
+	rules, prune := m.rules, m.prune
+	if len(refused) > 0 {
+		rules = make([]bundle.Rule, 0, len(m.rules))
+		for _, rule := range m.rules {
+			if _, ok := refused[rule.Context+"/"+rule.Name]; !ok {
+				rules = append(rules, rule)
+			}
+		}
+		// held back rules are missing in the document now and must not be pruned
+		prune = false
+	}
+
+	applied, err := r.importRules(ctx, rules, false, prune, true)
+	if err != nil {
+		return errors.Wrap(err, "apply")
+	}
+
+	for _, c := range applied.GetChanges() {
+		metrics.ReconcileApplied.WithLabelValues(service, actionLabel(c.GetAction())).Inc()
+		logger.Info("reconciled rule",
+			zap.String("service", service), zap.String("action", actionLabel(c.GetAction())),
+			zap.String("rule", c.GetContext()+"/"+c.GetName()), zap.Strings("details", c.GetDetails()))
+	}
+
+	return nil
+}
+
+func (r *Reconciler) importRules(ctx context.Context, rules []bundle.Rule, dryRun, prune, guard bool) (*desc.ImportRulesResponse, error) {
+	raw, err := bundle.Encode(bundle.Document{Version: bundle.Version, Rules: rules}, bundle.FormatJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "encode document")
+	}
+
+	return r.importer.ImportRules(ctx, &desc.ImportRulesRequest{
+		Document:         string(raw),
+		Format:           desc.DocumentFormat_DOCUMENT_FORMAT_JSON,
+		DryRun:           dryRun,
+		Prune:            prune,
+		GuardDestructive: guard,
+	})
+}
+
+var driftActions = []desc.ImportAction{
+	desc.ImportAction_IMPORT_ACTION_CREATE,
+	desc.ImportAction_IMPORT_ACTION_UPDATE,
+	desc.ImportAction_IMPORT_ACTION_DISABLE,
+}
+
+func reportDrift(service string, changes []*desc.ImportChange) {
+	counts := make(map[desc.ImportAction]int, len(driftActions))
+	for _, c := range changes {
+		counts[c.GetAction()]++
+	}
+	for _, action := range driftActions {
+		metrics.ReconcileDrift.WithLabelValues(service, actionLabel(action)).Set(float64(counts[action]))
+	}
+}
+
+func actionLabel(action desc.ImportAction) string {
+	return strings.ToLower(strings.TrimPrefix(action.String(), "IMPORT_ACTION_"))
+}
+
+func loadManifests(dir string) ([]bundle.Document, error) {
+	var docs []bundle.Document
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		var format bundle.Format
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml":
+			format = bundle.FormatYAML
+		case ".json":
+			format = bundle.FormatJSON
+		default:
+			return nil
+		}
+
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		doc, err := bundle.Decode(raw, format)
+		if err != nil {
+			return errors.Wrapf(err, "manifest %s", path)
+		}
+		docs = append(docs, doc)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "load manifests")
+	}
+
+	return docs, nil
+}
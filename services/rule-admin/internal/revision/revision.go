@@ -0,0 +1,95 @@
+package revision
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+)
+
+var skipFields = map[string]struct{}{
+	"id":         {},
+	"rule_id":    {},
+	"created_at": {},
+	"updated_at": {},
+	"variants":   {},
+}
+
+func Diff(from, to model.Rule) ([]model.RevisionChange, error) {
+	fromFields, err := flatten(from)
+	if err != nil {
+		return nil, errors.Wrap(err, "flatten from")
+	}
+	toFields, err := flatten(to)
+	if err != nil {
+		return nil, errors.Wrap(err, "flatten to")
+	}
+
+	paths := make([]string, 0, len(fromFields)+len(toFields))
+	for path := range fromFields {
+		paths = append(paths, path)
+	}
+	for path := range toFields {
+		if _, ok := fromFields[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	changes := make([]model.RevisionChange, 0)
+	for _, path := range paths {
+		if fromFields[path] == toFields[path] {
+			continue
+		}
+		changes = append(changes, model.RevisionChange{
+			Path: path,
+			From: fromFields[path],
+			To:   toFields[path],
+		})
+	}
+
+	return changes, nil
+}
+
+func flatten(r model.Rule) (map[string]string, error) {
+	fields := make(map[string]string)
+
+	if err := flattenObject(fields, "", r); err != nil {
+		return nil, err
+	}
+
+	for _, v := range r.Variants {
+		if err := flattenObject(fields, fmt.Sprintf("variants[%s].", v.Id), v); err != nil {
+			return nil, err
+		}
+	}
+
+	return fields, nil
+}
+
+func flattenObject(fields map[string]string, prefix string, obj any) error {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return err
+	}
+
+	for key, value := range m {
+		if _, ok := skipFields[key]; ok {
+			continue
+		}
+		if string(value) == "null" {
+			continue
+		}
+		fields[prefix+key] = string(value)
+	}
+
+	return nil
+}
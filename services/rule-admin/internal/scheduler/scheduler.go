@@ -0,0 +1,157 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/EbumbaE/bandit/pkg/logger"
+	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
+	"github.com/EbumbaE/bandit/services/rule-admin/internal/provider"
+)
+
+type Storage interface {
+	GetRulesToActivate(ctx context.Context) ([]string, error)
+	GetRulesToDeactivate(ctx context.Context) ([]string, error)
+	ClearRuleStart(ctx context.Context, id string) error
+	ClearRuleEnd(ctx context.Context, id string) error
+
+	GetVariantsToActivate(ctx context.Context) ([]model.Variant, error)
+	GetVariantsToDeactivate(ctx context.Context) ([]model.Variant, error)
+	ClearVariantStart(ctx context.Context, id string) error
+	ClearVariantEnd(ctx context.Context, id string) error
+}
+
+type Provider interface {
+	SetScheduledRuleState(ctx context.Context, id string, state model.StateType) error
+	SetVariantState(ctx context.Context, ruleID, variantID string, state model.StateType) error
+}
+
+type Scheduler struct {
+	storage  Storage
+	provider Provider
+	interval time.Duration
+}
+
+const defaultInterval = 10 * time.Second
+
+// NewScheduler falls back to the default for a non-positive interval, time.NewTicker
+// panics on a zero one.
+func NewScheduler(storage Storage, provider Provider, interval time.Duration) *Scheduler {
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	return &Scheduler{
+		storage:  storage,
+		provider: provider,
+		interval: interval,
+	}
+}
+
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.processRules(ctx); err != nil {
+				logger.Error("process rule schedule", zap.Error(err))
+			}
+			if err := s.processVariants(ctx); err != nil {
+				logger.Error("process variant schedule", zap.Error(err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Scheduler) processRules(ctx context.Context) error {
+	toDeactivate, err := s.storage.GetRulesToDeactivate(ctx)
+	if err != nil {
+		return errors.Wrap(err, "storage.GetRulesToDeactivate")
+	}
+
+	for _, id := range toDeactivate {
+		if err := s.provider.SetScheduledRuleState(ctx, id, model.StateTypeDisable); err != nil {
+			if !errors.Is(err, provider.ErrApprovalRequired) {
+				logger.Error("scheduled rule deactivation", zap.String("rule_id", id), zap.Error(err))
+				continue
+			}
+			logger.Info("scheduled rule deactivation requires approval", zap.String("rule_id", id), zap.Error(err))
+			if err := s.storage.ClearRuleEnd(ctx, id); err != nil {
+				logger.Error("clear rule schedule", zap.String("rule_id", id), zap.Error(err))
+			}
+			continue
+		}
+		if err := s.storage.ClearRuleEnd(ctx, id); err != nil {
+			logger.Error("clear rule end", zap.String("rule_id", id), zap.Error(err))
+		}
+		logger.Info("rule deactivated by schedule", zap.String("rule_id", id))
+	}
+
+	toActivate, err := s.storage.GetRulesToActivate(ctx)
+	if err != nil {
+		return errors.Wrap(err, "storage.GetRulesToActivate")
+	}
+
+	for _, id := range toActivate {
+		if err := s.provider.SetScheduledRuleState(ctx, id, model.StateTypeEnable); err != nil {
+			if !errors.Is(err, provider.ErrApprovalRequired) {
+				logger.Error("scheduled rule activation", zap.String("rule_id", id), zap.Error(err))
+				continue
+			}
+			logger.Info("scheduled rule activation requires approval", zap.String("rule_id", id), zap.Error(err))
+			if err := s.storage.ClearRuleStart(ctx, id); err != nil {
+				logger.Error("clear rule schedule", zap.String("rule_id", id), zap.Error(err))
+			}
+			continue
+		}
+		if err := s.storage.ClearRuleStart(ctx, id); err != nil {
+			logger.Error("clear rule start", zap.String("rule_id", id), zap.Error(err))
+		}
+		logger.Info("rule activated by schedule", zap.String("rule_id", id))
+	}
+
+	return nil
+}
+
+func (s *Scheduler) processVariants(ctx context.Context) error {
+	toDeactivate, err := s.storage.GetVariantsToDeactivate(ctx)
+	if err != nil {
+		return errors.Wrap(err, "storage.GetVariantsToDeactivate")
+	}
+
+	for _, v := range toDeactivate {
+		if err := s.provider.SetVariantState(ctx, v.RuleId, v.Id, model.StateTypeDisable); err != nil {
+			logger.Error("scheduled variant deactivation", zap.String("variant_id", v.Id), zap.Error(err))
+			continue
+		}
+		if err := s.storage.ClearVariantEnd(ctx, v.Id); err != nil {
+			logger.Error("clear variant end", zap.String("variant_id", v.Id), zap.Error(err))
+		}
+		logger.Info("variant deactivated by schedule", zap.String("rule_id", v.RuleId), zap.String("variant_id", v.Id))
+	}
+
+	toActivate, err := s.storage.GetVariantsToActivate(ctx)
+	if err != nil {
+		return errors.Wrap(err, "storage.GetVariantsToActivate")
+	}
+
+	for _, v := range toActivate {
+		if err := s.provider.SetVariantState(ctx, v.RuleId, v.Id, model.StateTypeEnable); err != nil {
+			logger.Error("scheduled variant activation", zap.String("variant_id", v.Id), zap.Error(err))
+			continue
+		}
+		if err := s.storage.ClearVariantStart(ctx, v.Id); err != nil {
+			logger.Error("clear variant start", zap.String("variant_id", v.Id), zap.Error(err))
+		}
+		logger.Info("variant activated by schedule", zap.String("rule_id", v.RuleId), zap.String("variant_id", v.Id))
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const resourceURL = "mem://variant_schema.json"
+
+func Compile(schema string) (*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(resourceURL, strings.NewReader(schema)); err != nil {
+		return nil, errors.Wrap(err, "add schema resource")
+	}
+
+	s, err := compiler.Compile(resourceURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "compile schema")
+	}
+
+	return s, nil
+}
+
+func Validate(s *jsonschema.Schema, data string) error {
+	var v any
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		return errors.Wrap(err, "unmarshal data")
+	}
+
+	return s.Validate(v)
+}
@@ -0,0 +1,187 @@
+CREATE TABLE IF NOT EXISTS wanted_registry (
+    bandit_key TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS rule_info (
+    id UUID PRIMARY KEY,
+
+    name TEXT NOT NULL,
+    description TEXT NOT NULL,
+    state TEXT NOT NULL,
+
+    bandit_key TEXT NOT NULL,
+    service TEXT NOT NULL,
+    context TEXT NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS variant_info (
+    id UUID PRIMARY KEY,
+    rule_id UUID,
+
+    name TEXT NOT NULL,
+    data JSONB,
+    state TEXT NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS variant_info_rule_id ON variant_info(rule_id);
+
+CREATE TABLE IF NOT EXISTS layer_info (
+    id UUID PRIMARY KEY,
+
+    name TEXT NOT NULL,
+    description TEXT NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMP
+);
+
+ALTER TABLE rule_info ADD COLUMN IF NOT EXISTS layer_id UUID;
+CREATE INDEX IF NOT EXISTS rule_info_layer_id ON rule_info(layer_id);
+
+ALTER TABLE rule_info ADD COLUMN IF NOT EXISTS start_at TIMESTAMP;
+ALTER TABLE rule_info ADD COLUMN IF NOT EXISTS end_at TIMESTAMP;
+ALTER TABLE variant_info ADD COLUMN IF NOT EXISTS start_at TIMESTAMP;
+ALTER TABLE variant_info ADD COLUMN IF NOT EXISTS end_at TIMESTAMP;
+
+ALTER TABLE rule_info ADD COLUMN IF NOT EXISTS priority INT NOT NULL DEFAULT 0;
+ALTER TABLE rule_info ADD COLUMN IF NOT EXISTS targeting JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE rule_info ADD COLUMN IF NOT EXISTS variant_schema JSONB;
+
+CREATE INDEX IF NOT EXISTS rule_info_service_context ON rule_info(service, context);
+CREATE INDEX IF NOT EXISTS rule_info_bandit_key ON rule_info(bandit_key);
+CREATE INDEX IF NOT EXISTS rule_info_created_at ON rule_info(created_at, id);
+CREATE INDEX IF NOT EXISTS rule_info_updated_at ON rule_info(updated_at, id);
+CREATE INDEX IF NOT EXISTS rule_info_name ON rule_info(name, id);
+
+CREATE TABLE IF NOT EXISTS guardrail_info (
+    id UUID PRIMARY KEY,
+    rule_id UUID NOT NULL,
+
+    metric TEXT NOT NULL,
+    threshold DOUBLE PRECISION NOT NULL,
+    window_sec BIGINT NOT NULL,
+    min_count BIGINT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS guardrail_info_rule_id ON guardrail_info(rule_id);
+
+CREATE TABLE IF NOT EXISTS audit_event (
+    id UUID PRIMARY KEY,
+
+    actor TEXT NOT NULL,
+    method TEXT NOT NULL,
+    rule_id UUID,
+    request JSONB,
+    prev_state JSONB,
+    new_state JSONB,
+    error TEXT NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS audit_event_rule_id ON audit_event(rule_id, created_at);
+CREATE INDEX IF NOT EXISTS audit_event_actor ON audit_event(actor, created_at);
+CREATE INDEX IF NOT EXISTS audit_event_created_at ON audit_event(created_at);
+
+CREATE TABLE IF NOT EXISTS tenant_info (
+    name TEXT PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+
+    max_rules INT NOT NULL DEFAULT 0,
+    max_variants INT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS tenant_service (
+    service TEXT PRIMARY KEY,
+    tenant TEXT NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS tenant_service_tenant ON tenant_service(tenant);
+
+ALTER TABLE layer_info ADD COLUMN IF NOT EXISTS tenant TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS rule_revision (
+    id UUID PRIMARY KEY,
+    rule_id UUID NOT NULL,
+    revision BIGINT NOT NULL,
+
+    reason TEXT NOT NULL,
+    snapshot JSONB NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE (rule_id, revision)
+);
+
+ALTER TABLE rule_info ADD COLUMN IF NOT EXISTS protected BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS change_request (
+    id UUID PRIMARY KEY,
+
+    operation TEXT NOT NULL,
+    rule_id UUID,
+    service TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    status TEXT NOT NULL,
+
+    author TEXT NOT NULL,
+    reviewer TEXT NOT NULL DEFAULT '',
+    comment TEXT NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS change_request_rule_id ON change_request(rule_id, created_at);
+CREATE INDEX IF NOT EXISTS change_request_status ON change_request(status, created_at);
+
+CREATE TABLE IF NOT EXISTS rule_template (
+    id UUID PRIMARY KEY,
+
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    bandit_key TEXT NOT NULL,
+    variant_schema JSONB,
+    priority INT NOT NULL DEFAULT 0,
+    targeting JSONB NOT NULL DEFAULT '[]',
+    variants JSONB NOT NULL DEFAULT '[]',
+    tenant TEXT NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS rule_template_name ON rule_template(name) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS outbox_event (
+    id BIGSERIAL PRIMARY KEY,
+
+    key TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
@@ -0,0 +1,5 @@
+ALTER TABLE wanted_registry DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE wanted_registry DROP COLUMN IF EXISTS deprecation_reason;
+ALTER TABLE wanted_registry DROP COLUMN IF EXISTS deprecated_at;
+ALTER TABLE wanted_registry DROP COLUMN IF EXISTS default_config;
+ALTER TABLE wanted_registry DROP COLUMN IF EXISTS config_schema;
@@ -0,0 +1,5 @@
+ALTER TABLE wanted_registry ADD COLUMN IF NOT EXISTS config_schema JSONB;
+ALTER TABLE wanted_registry ADD COLUMN IF NOT EXISTS default_config JSONB;
+ALTER TABLE wanted_registry ADD COLUMN IF NOT EXISTS deprecated_at TIMESTAMP;
+ALTER TABLE wanted_registry ADD COLUMN IF NOT EXISTS deprecation_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE wanted_registry ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT now();
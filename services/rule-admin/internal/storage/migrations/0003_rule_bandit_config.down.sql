@@ -0,0 +1 @@
+ALTER TABLE rule_info DROP COLUMN IF EXISTS bandit_config;
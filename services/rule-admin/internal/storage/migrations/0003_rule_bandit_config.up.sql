@@ -0,0 +1 @@
+ALTER TABLE rule_info ADD COLUMN IF NOT EXISTS bandit_config JSONB;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS rule_info_default_rule;
@@ -0,0 +1,25 @@
+-- rule_info_default_rule can't be built over duplicate enabled default rules,
+-- list them so they are disabled or given targeting before migrating.
+DO $$
+DECLARE
+    duplicates TEXT;
+BEGIN
+    SELECT string_agg(format('%s/%s: %s', service, context, ids), E'\n')
+    INTO duplicates
+    FROM (
+        SELECT service, context, string_agg(id::text, ', ' ORDER BY updated_at DESC) AS ids
+        FROM rule_info
+        WHERE state = 'enabled' AND targeting = '[]'::jsonb AND deleted_at IS NULL
+        GROUP BY service, context
+        HAVING COUNT(*) > 1
+    ) d;
+
+    IF duplicates IS NOT NULL THEN
+        RAISE EXCEPTION 'duplicate enabled default rules per service/context:%', E'\n' || duplicates
+            USING HINT = 'keep one enabled rule without targeting per service/context and rerun the migration';
+    END IF;
+END
+$$;
+
+CREATE UNIQUE INDEX IF NOT EXISTS rule_info_default_rule ON rule_info(service, context)
+    WHERE state = 'enabled' AND targeting = '[]'::jsonb AND deleted_at IS NULL;
@@ -2,81 +2,69 @@ package storage
 
 import (
 	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pkg/errors"
 
+	"github.com/EbumbaE/bandit/pkg/migration"
 	"github.com/EbumbaE/bandit/pkg/psql"
 	model "github.com/EbumbaE/bandit/services/rule-admin/internal"
 )
 
-var ErrNotFound = pgx.ErrNoRows
+var (
+	ErrNotFound      = pgx.ErrNoRows
+	ErrAlreadyExists = errors.New("already exists")
+)
+
+const uniqueViolationCode = "23505"
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
 
 type Storage struct {
 	conn psql.Database
 }
 
 func New(ctx context.Context, conn psql.Database) (*Storage, error) {
-	if err := initSchema(ctx, conn); err != nil {
-		return nil, errors.Wrap(err, "init schema")
+	m, err := NewMigrator(conn)
+	if err != nil {
+		return nil, errors.Wrap(err, "load migrations")
+	}
+	if _, err := m.Up(ctx); err != nil {
+		return nil, errors.Wrap(err, "migrate schema")
 	}
+
 	return &Storage{
 		conn: conn,
 	}, nil
 }
 
-func initSchema(ctx context.Context, db psql.Database) error {
-	query := `
-		CREATE TABLE IF NOT EXISTS wanted_registry (
-			bandit_key TEXT NOT NULL PRIMARY KEY,
-			name TEXT NOT NULL,
-			
-			created_at TIMESTAMP NOT NULL DEFAULT now(),
-			deleted_at TIMESTAMP
-		);
-
-		CREATE TABLE IF NOT EXISTS rule_info (
-			id UUID PRIMARY KEY,
-
-			name TEXT NOT NULL,
-			description TEXT NOT NULL,
-			state TEXT NOT NULL,
-
-			bandit_key TEXT NOT NULL,
-			service TEXT NOT NULL,
-			context TEXT NOT NULL,
-			
-			created_at TIMESTAMP NOT NULL DEFAULT now(),
-			updated_at TIMESTAMP NOT NULL DEFAULT now(),
-			deleted_at TIMESTAMP
-		);
-			
-		CREATE TABLE IF NOT EXISTS variant_info (
-			id UUID PRIMARY KEY,
-			rule_id UUID,
-			
-			name TEXT NOT NULL,
-			data JSONB,
-			state TEXT NOT NULL,
-			
-			created_at TIMESTAMP NOT NULL DEFAULT now(),
-			updated_at TIMESTAMP NOT NULL DEFAULT now(),
-			deleted_at TIMESTAMP
-		);
-		
-		CREATE INDEX IF NOT EXISTS variant_info_rule_id ON variant_info(rule_id);
-`
-
-	_, err := db.Exec(ctx, query)
-	return err
+// NewMigrator returns the migrator of the storage schema, New applies pending migrations.
+func NewMigrator(conn psql.Database) (*migration.Migrator, error) {
+	migrations, err := migration.Load(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	return psql.NewMigrator(conn, migrations), nil
 }
 
+const wantedBanditColumns = `bandit_key, name, COALESCE(config_schema::text, '') AS config_schema,
+			COALESCE(default_config::text, '') AS default_config, deprecated_at, deprecation_reason, created_at, updated_at`
+
 func (s *Storage) GetWantedRegistry(ctx context.Context) ([]model.WantedBandit, error) {
 	var wr []model.WantedBandit
 
 	query := `
-		SELECT bandit_key, name
-		FROM wanted_registry;
+		SELECT ` + wantedBanditColumns + `
+		FROM wanted_registry
+		WHERE deleted_at IS NULL
+		ORDER BY bandit_key;
 		`
 
 	err := s.conn.GetSlice(ctx, &wr, query)
@@ -84,44 +72,122 @@ func (s *Storage) GetWantedRegistry(ctx context.Context) ([]model.WantedBandit,
 	return wr, err
 }
 
+func (s *Storage) GetWantedBandit(ctx context.Context, banditKey string) (model.WantedBandit, error) {
+	var wb model.WantedBandit
+
+	query := `
+		SELECT ` + wantedBanditColumns + `
+		FROM wanted_registry
+		WHERE bandit_key = $1 AND deleted_at IS NULL;
+`
+
+	err := s.conn.GetSingle(ctx, &wb, query, banditKey)
+	if len(wb.BanditKey) == 0 || errors.Is(err, pgx.ErrNoRows) {
+		return model.WantedBandit{}, ErrNotFound
+	}
+
+	return wb, err
+}
+
 func (s *Storage) CreateWantedBandit(ctx context.Context, wb model.WantedBandit) error {
 	query := `
 		INSERT INTO wanted_registry
 		(
-			created_at,
-			bandit_key, name
+			created_at, updated_at,
+			bandit_key, name, config_schema, default_config
 		)
 		VALUES
 		(
-			NOW() at time zone 'utc',
-			$1, $2
+			NOW() at time zone 'utc', NOW() at time zone 'utc',
+			$1, $2, NULLIF($3, '')::jsonb, NULLIF($4, '')::jsonb
 		);
 `
 
-	_, err := s.conn.Exec(ctx, query, wb.BanditKey, wb.Name)
+	_, err := s.conn.Exec(ctx, query, wb.BanditKey, wb.Name, wb.ConfigSchema, wb.DefaultConfig)
+	if isUniqueViolation(err) {
+		return ErrAlreadyExists
+	}
 
 	return err
 }
 
-func (s *Storage) CheckWantedBandit(ctx context.Context, banditKey string) (bool, error) {
+func (s *Storage) UpdateWantedBandit(ctx context.Context, wb model.WantedBandit) error {
 	query := `
-		SELECT COUNT(*)
-		FROM wanted_registry
-		WHERE bandit_key = $1;
+		UPDATE wanted_registry 
+		SET 
+			name = $2,
+			config_schema = NULLIF($3, '')::jsonb,
+			default_config = NULLIF($4, '')::jsonb,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE bandit_key = $1 AND deleted_at IS NULL;
 `
-	var count int
-	err := s.conn.GetSingle(ctx, &count, query, banditKey)
 
-	return count == 1, err
+	tag, err := s.conn.Exec(ctx, query, wb.BanditKey, wb.Name, wb.ConfigSchema, wb.DefaultConfig)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// SetWantedBanditDeprecated keeps the first deprecation time when the key is deprecated again.
+func (s *Storage) SetWantedBanditDeprecated(ctx context.Context, banditKey string, deprecated bool, reason string) error {
+	query := `
+		UPDATE wanted_registry 
+		SET 
+			deprecated_at = CASE WHEN $2 THEN COALESCE(deprecated_at, NOW() at time zone 'utc') END,
+			deprecation_reason = $3,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE bandit_key = $1 AND deleted_at IS NULL;
+`
+
+	tag, err := s.conn.Exec(ctx, query, banditKey, deprecated, reason)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ListStaleBanditRules returns rules whose bandit key is deprecated or missing
+// from the registry, nil services means all.
+func (s *Storage) ListStaleBanditRules(ctx context.Context, services []string) ([]model.Rule, error) {
+	var rules []model.Rule
+
+	query := `
+		SELECT ` + ruleColumns + `
+		FROM rule_info r
+		WHERE deleted_at IS NULL AND ($1::text[] IS NULL OR service = ANY($1))
+			AND NOT EXISTS (
+				SELECT 1
+				FROM wanted_registry w
+				WHERE w.bandit_key = r.bandit_key AND w.deleted_at IS NULL AND w.deprecated_at IS NULL
+			)
+		ORDER BY bandit_key, service, context, created_at;
+`
+
+	err := s.conn.GetSlice(ctx, &rules, query, services)
+
+	return rules, err
 }
 
+const ruleColumns = `id, name, description, state, bandit_key, service, context, COALESCE(layer_id::text, '') AS layer_id,
+			start_at, end_at, priority, targeting, COALESCE(variant_schema::text, '') AS variant_schema, protected,
+			COALESCE(bandit_config::text, '') AS bandit_config, created_at, updated_at`
+
 func (s *Storage) GetRule(ctx context.Context, id string) (model.Rule, error) {
 	var r model.Rule
 
 	query := `
-		SELECT id, name, description, state, bandit_key, service, context
+		SELECT ` + ruleColumns + `
 		FROM rule_info
-		WHERE id = $1;
+		WHERE id = $1 AND deleted_at IS NULL;
 		`
 
 	err := s.conn.GetSingle(ctx, &r, query, id)
@@ -132,28 +198,110 @@ func (s *Storage) GetRule(ctx context.Context, id string) (model.Rule, error) {
 	return r, err
 }
 
-func (s *Storage) GetRuleServiceContext(ctx context.Context, ruleID string) (string, string, error) {
+func (s *Storage) ListRules(ctx context.Context, f model.RuleFilter) ([]model.Rule, error) {
+	var (
+		conds = []string{"deleted_at IS NULL"}
+		args  []any
+	)
+
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if len(f.Service) > 0 {
+		add("service = $%d", f.Service)
+	}
+	if f.Services != nil {
+		add("service = ANY($%d)", f.Services)
+	}
+	if len(f.Context) > 0 {
+		add("context = $%d", f.Context)
+	}
+	if len(f.State) > 0 {
+		add("state = $%d", f.State)
+	}
+	if len(f.BanditKey) > 0 {
+		add("bandit_key = $%d", f.BanditKey)
+	}
+	if len(f.Name) > 0 {
+		add(`name ILIKE '%%' || $%d || '%%' ESCAPE '\'`, escapeLike(f.Name))
+	}
+	if f.CreatedFrom != nil {
+		add("created_at >= $%d", *f.CreatedFrom)
+	}
+	if f.CreatedTo != nil {
+		add("created_at < $%d", *f.CreatedTo)
+	}
+	if f.UpdatedFrom != nil {
+		add("updated_at >= $%d", *f.UpdatedFrom)
+	}
+	if f.UpdatedTo != nil {
+		add("updated_at < $%d", *f.UpdatedTo)
+	}
+
+	sortColumn := string(model.RuleSortFieldCreatedAt)
+	switch f.SortField {
+	case model.RuleSortFieldUpdatedAt, model.RuleSortFieldName:
+		sortColumn = string(f.SortField)
+	}
+
+	direction, cmp := "ASC", ">"
+	if f.SortDesc {
+		direction, cmp = "DESC", "<"
+	}
+
+	if f.Cursor != nil {
+		valueCast := "::timestamp"
+		if sortColumn == string(model.RuleSortFieldName) {
+			valueCast = "::text"
+		}
+
+		args = append(args, f.Cursor.Value, f.Cursor.Id)
+		conds = append(conds, fmt.Sprintf("(%s, id) %s ($%d%s, $%d::uuid)", sortColumn, cmp, len(args)-1, valueCast, len(args)))
+	}
+
+	args = append(args, f.Limit)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM rule_info
+		WHERE %s
+		ORDER BY %s %s, id %s
+		LIMIT $%d;
+`, ruleColumns, strings.Join(conds, " AND "), sortColumn, direction, direction, len(args))
+
+	var rules []model.Rule
+	err := s.conn.GetSlice(ctx, &rules, query, args...)
+
+	return rules, err
+}
+
+func escapeLike(v string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(v)
+}
+
+func (s *Storage) GetRuleServiceContext(ctx context.Context, ruleID string) (model.Rule, error) {
 	var r model.Rule
 
 	query := `
-		SELECT service, context
+		SELECT service, context, COALESCE(layer_id::text, '') AS layer_id, state, priority, targeting
 		FROM rule_info
 		WHERE id = $1;
 `
 
 	err := s.conn.GetSingle(ctx, &r, query, ruleID)
 	if len(r.Service) == 0 || errors.Is(err, pgx.ErrNoRows) {
-		return "", "", ErrNotFound
+		return model.Rule{}, ErrNotFound
 	}
 
-	return r.Service, r.Context, err
+	return r, err
 }
 
 func (s *Storage) GetActiveRuleByServiceContext(ctx context.Context, service, context string) (string, error) {
 	query := `
 		SELECT id
 		FROM rule_info
-		WHERE service = $1 AND context = $2 AND state = $3;
+		WHERE service = $1 AND context = $2 AND state = $3 AND targeting = '[]'::jsonb AND deleted_at IS NULL;
 `
 
 	var id string
@@ -170,18 +318,30 @@ func (s *Storage) CreateRule(ctx context.Context, rule model.Rule) (model.Rule,
 		INSERT INTO rule_info
 		(
 			id, created_at, updated_at,
-			name, description, state, bandit_key, service, context
+			name, description, state, bandit_key, service, context, layer_id, start_at, end_at, priority, targeting, variant_schema, protected,
+			bandit_config
 		)
 		VALUES
 		(
 			gen_random_uuid(), NOW() at time zone 'utc', NOW() at time zone 'utc',
-			$1, $2, $3, $4, $5, $6
+			$1, $2, $3, $4, $5, $6, NULLIF($7, '')::uuid, $8, $9, $10, $11, NULLIF($12, '')::jsonb, $13,
+			NULLIF($14, '')::jsonb
 		)
 		RETURNING id;
 `
 
+	if rule.Targeting == nil {
+		rule.Targeting = []model.TargetingCondition{}
+	}
+
 	var id string
-	err := s.conn.QueryRow(ctx, query, rule.Name, rule.Description, rule.State, rule.BanditKey, rule.Service, rule.Context).Scan(&id)
+	err := s.conn.QueryRow(ctx, query,
+		rule.Name, rule.Description, rule.State, rule.BanditKey, rule.Service, rule.Context, rule.LayerId, rule.StartAt, rule.EndAt,
+		rule.Priority, rule.Targeting, rule.VariantSchema, rule.Protected, rule.BanditConfig,
+	).Scan(&id)
+	if isUniqueViolation(err) {
+		return model.Rule{}, ErrAlreadyExists
+	}
 
 	rule.Id = id
 
@@ -213,77 +373,1153 @@ func (s *Storage) SetRuleState(ctx context.Context, id string, state model.State
 `
 
 	_, err := s.conn.Exec(ctx, query, id, state)
+	if isUniqueViolation(err) {
+		return ErrAlreadyExists
+	}
 
 	return err
 }
 
-func (s *Storage) GetVariant(ctx context.Context, ruleID, variantID string) (model.Variant, error) {
-	var v model.Variant
+func (s *Storage) DeleteRule(ctx context.Context, id string) error {
+	ruleQuery := `
+		UPDATE rule_info 
+		SET 
+			state = $2,
+			updated_at = NOW() at time zone 'utc',
+			deleted_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	variantQuery := `
+		UPDATE variant_info 
+		SET 
+			state = $2,
+			updated_at = NOW() at time zone 'utc',
+			deleted_at = NOW() at time zone 'utc' 
+		WHERE rule_id = $1 AND deleted_at IS NULL;
+`
+
+	return s.conn.WrapWithTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, ruleQuery, id, model.StateTypeDisable); err != nil {
+			return errors.Wrap(err, "delete rule")
+		}
+		if _, err := tx.Exec(ctx, variantQuery, id, model.StateTypeDisable); err != nil {
+			return errors.Wrap(err, "delete rule variants")
+		}
+		return nil
+	})
+}
 
+func (s *Storage) SetRuleSchedule(ctx context.Context, id string, startAt, endAt *time.Time) error {
 	query := `
-		SELECT id, name, data, state
-		FROM variant_info
-		WHERE id = $1 AND rule_id = $2;
+		UPDATE rule_info 
+		SET 
+			start_at = $2,
+			end_at = $3,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
 `
 
-	err := s.conn.GetSingle(ctx, &v, query, variantID, ruleID)
-	if len(v.Id) == 0 || errors.Is(err, pgx.ErrNoRows) {
-		return model.Variant{}, ErrNotFound
-	}
+	_, err := s.conn.Exec(ctx, query, id, startAt, endAt)
 
-	return v, err
+	return err
 }
 
-func (s *Storage) GetVariants(ctx context.Context, ruleID string) ([]model.Variant, error) {
-	var v []model.Variant
+func (s *Storage) GetRulesToActivate(ctx context.Context) ([]string, error) {
+	var ids []string
 
 	query := `
-		SELECT id, name, data, state
-		FROM variant_info
-		WHERE rule_id = $1;
+		SELECT id
+		FROM rule_info
+		WHERE state = $1 AND deleted_at IS NULL
+			AND start_at <= NOW() at time zone 'utc'
+			AND (end_at IS NULL OR end_at > NOW() at time zone 'utc');
 `
 
-	err := s.conn.GetSlice(ctx, &v, query, ruleID)
-	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, ErrNotFound
+	err := s.conn.GetSlice(ctx, &ids, query, model.StateTypeDisable)
+
+	return ids, err
+}
+
+func (s *Storage) GetRulesToDeactivate(ctx context.Context) ([]string, error) {
+	var ids []string
+
+	query := `
+		SELECT id
+		FROM rule_info
+		WHERE state = $1 AND deleted_at IS NULL
+			AND end_at <= NOW() at time zone 'utc';
+`
+
+	err := s.conn.GetSlice(ctx, &ids, query, model.StateTypeEnable)
+
+	return ids, err
+}
+
+func (s *Storage) ClearRuleStart(ctx context.Context, id string) error {
+	query := `
+		UPDATE rule_info 
+		SET 
+			start_at = NULL,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id)
+
+	return err
+}
+
+func (s *Storage) ClearRuleEnd(ctx context.Context, id string) error {
+	query := `
+		UPDATE rule_info 
+		SET 
+			start_at = NULL,
+			end_at = NULL,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id)
+
+	return err
+}
+
+func (s *Storage) SetRuleVariantSchema(ctx context.Context, id, variantSchema string) error {
+	query := `
+		UPDATE rule_info 
+		SET 
+			variant_schema = NULLIF($2, '')::jsonb,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id, variantSchema)
+
+	return err
+}
+
+func (s *Storage) SetRuleBanditConfig(ctx context.Context, id, banditConfig string) error {
+	query := `
+		UPDATE rule_info 
+		SET 
+			bandit_config = NULLIF($2, '')::jsonb,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id, banditConfig)
+
+	return err
+}
+
+func (s *Storage) SetRuleBanditKey(ctx context.Context, id, banditKey string) error {
+	query := `
+		UPDATE rule_info 
+		SET 
+			bandit_key = $2,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1 AND deleted_at IS NULL;
+`
+
+	tag, err := s.conn.Exec(ctx, query, id, banditKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
 	}
 
-	return v, err
+	return nil
 }
 
-func (s *Storage) AddVariant(ctx context.Context, ruleID string, v model.Variant) (model.Variant, error) {
+func (s *Storage) SetRuleTargeting(ctx context.Context, id string, priority int32, targeting []model.TargetingCondition) error {
 	query := `
-		INSERT INTO variant_info
+		UPDATE rule_info 
+		SET 
+			priority = $2,
+			targeting = $3,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	if targeting == nil {
+		targeting = []model.TargetingCondition{}
+	}
+
+	_, err := s.conn.Exec(ctx, query, id, priority, targeting)
+	if isUniqueViolation(err) {
+		return ErrAlreadyExists
+	}
+
+	return err
+}
+
+func (s *Storage) SetRuleLayer(ctx context.Context, id, layerID string) error {
+	query := `
+		UPDATE rule_info 
+		SET 
+			layer_id = NULLIF($2, '')::uuid,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id, layerID)
+
+	return err
+}
+
+func (s *Storage) SetRuleProtected(ctx context.Context, id string, protected bool) error {
+	query := `
+		UPDATE rule_info 
+		SET 
+			protected = $2,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id, protected)
+
+	return err
+}
+
+func (s *Storage) HasProtectedRule(ctx context.Context, service, context string) (bool, error) {
+	var exists bool
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM rule_info
+			WHERE service = $1 AND context = $2 AND protected AND deleted_at IS NULL
+		);
+`
+
+	err := s.conn.GetSingle(ctx, &exists, query, service, context)
+
+	return exists, err
+}
+
+func (s *Storage) CreateLayer(ctx context.Context, layer model.Layer) (model.Layer, error) {
+	query := `
+		INSERT INTO layer_info
 		(
 			id, created_at, updated_at,
-			rule_id, name, data, state
+			name, description, tenant
 		)
 		VALUES
 		(
 			gen_random_uuid(), NOW() at time zone 'utc', NOW() at time zone 'utc',
-			$1, $2, $3, $4
+			$1, $2, $3
 		)
 		RETURNING id;
 `
 
 	var id string
-	err := s.conn.QueryRow(ctx, query, ruleID, v.Name, v.Data, v.State).Scan(&id)
+	err := s.conn.QueryRow(ctx, query, layer.Name, layer.Description, layer.Tenant).Scan(&id)
 
-	v.Id = id
+	layer.Id = id
 
-	return v, err
+	return layer, err
 }
 
-func (s *Storage) SetVariantState(ctx context.Context, id string, state model.StateType) error {
+func (s *Storage) GetLayer(ctx context.Context, id string) (model.Layer, error) {
+	var l model.Layer
+
 	query := `
-		UPDATE variant_info 
-		SET 
-			state = $2,
-			updated_at = NOW() at time zone 'utc' 
-		WHERE id = $1;
+		SELECT id, name, description, tenant
+		FROM layer_info
+		WHERE id = $1 AND deleted_at IS NULL;
 `
 
-	_, err := s.conn.Exec(ctx, query, id, state)
+	err := s.conn.GetSingle(ctx, &l, query, id)
+	if len(l.Id) == 0 || errors.Is(err, pgx.ErrNoRows) {
+		return model.Layer{}, ErrNotFound
+	}
 
-	return err
+	return l, err
+}
+
+func (s *Storage) GetLayersByName(ctx context.Context, name string) ([]model.Layer, error) {
+	var layers []model.Layer
+
+	query := `
+		SELECT id, name, description, tenant
+		FROM layer_info
+		WHERE name = $1 AND deleted_at IS NULL
+		ORDER BY id;
+`
+
+	err := s.conn.GetSlice(ctx, &layers, query, name)
+
+	return layers, err
+}
+
+func (s *Storage) GetLayerActiveRules(ctx context.Context, layerID string) ([]string, error) {
+	var ids []string
+
+	query := `
+		SELECT id
+		FROM rule_info
+		WHERE layer_id = $1 AND state = $2 AND deleted_at IS NULL
+		ORDER BY id;
+`
+
+	err := s.conn.GetSlice(ctx, &ids, query, layerID, model.StateTypeEnable)
+
+	return ids, err
+}
+
+func (s *Storage) GetVariant(ctx context.Context, ruleID, variantID string) (model.Variant, error) {
+	var v model.Variant
+
+	query := `
+		SELECT id, rule_id, name, data, state, start_at, end_at
+		FROM variant_info
+		WHERE id = $1 AND rule_id = $2 AND deleted_at IS NULL;
+`
+
+	err := s.conn.GetSingle(ctx, &v, query, variantID, ruleID)
+	if len(v.Id) == 0 || errors.Is(err, pgx.ErrNoRows) {
+		return model.Variant{}, ErrNotFound
+	}
+
+	return v, err
+}
+
+func (s *Storage) GetVariants(ctx context.Context, ruleID string) ([]model.Variant, error) {
+	var v []model.Variant
+
+	query := `
+		SELECT id, rule_id, name, data, state, start_at, end_at
+		FROM variant_info
+		WHERE rule_id = $1 AND deleted_at IS NULL;
+`
+
+	err := s.conn.GetSlice(ctx, &v, query, ruleID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	return v, err
+}
+
+func (s *Storage) AddVariant(ctx context.Context, ruleID string, v model.Variant) (model.Variant, error) {
+	query := `
+		INSERT INTO variant_info
+		(
+			id, created_at, updated_at,
+			rule_id, name, data, state, start_at, end_at
+		)
+		VALUES
+		(
+			gen_random_uuid(), NOW() at time zone 'utc', NOW() at time zone 'utc',
+			$1, $2, $3, $4, $5, $6
+		)
+		RETURNING id;
+`
+
+	var id string
+	err := s.conn.QueryRow(ctx, query, ruleID, v.Name, v.Data, v.State, v.StartAt, v.EndAt).Scan(&id)
+
+	v.Id = id
+	v.RuleId = ruleID
+
+	return v, err
+}
+
+func (s *Storage) UpdateVariant(ctx context.Context, v model.Variant) (model.Variant, error) {
+	query := `
+		UPDATE variant_info 
+		SET 
+			name = $2,
+			data = $3,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1 AND deleted_at IS NULL;
+`
+
+	_, err := s.conn.Exec(ctx, query, v.Id, v.Name, v.Data)
+
+	return v, err
+}
+
+func (s *Storage) DeleteVariant(ctx context.Context, id string) error {
+	query := `
+		UPDATE variant_info 
+		SET 
+			state = $2,
+			updated_at = NOW() at time zone 'utc',
+			deleted_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id, model.StateTypeDisable)
+
+	return err
+}
+
+// RestoreVariant un-deletes the variant keeping its id, so arm statistics in
+// the indexer stay bound to it.
+func (s *Storage) RestoreVariant(ctx context.Context, ruleID string, v model.Variant) error {
+	query := `
+		UPDATE variant_info 
+		SET 
+			name = $3,
+			data = $4,
+			state = $5,
+			start_at = $6,
+			end_at = $7,
+			updated_at = NOW() at time zone 'utc',
+			deleted_at = NULL
+		WHERE id = $1 AND rule_id = $2 AND deleted_at IS NOT NULL;
+`
+
+	tag, err := s.conn.Exec(ctx, query, v.Id, ruleID, v.Name, v.Data, v.State, v.StartAt, v.EndAt)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *Storage) SetVariantState(ctx context.Context, id string, state model.StateType) error {
+	query := `
+		UPDATE variant_info 
+		SET 
+			state = $2,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id, state)
+
+	return err
+}
+
+func (s *Storage) SetVariantSchedule(ctx context.Context, id string, startAt, endAt *time.Time) error {
+	query := `
+		UPDATE variant_info 
+		SET 
+			start_at = $2,
+			end_at = $3,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id, startAt, endAt)
+
+	return err
+}
+
+func (s *Storage) GetVariantsToActivate(ctx context.Context) ([]model.Variant, error) {
+	var v []model.Variant
+
+	query := `
+		SELECT id, rule_id, name, data, state, start_at, end_at
+		FROM variant_info
+		WHERE state = $1 AND deleted_at IS NULL
+			AND start_at <= NOW() at time zone 'utc'
+			AND (end_at IS NULL OR end_at > NOW() at time zone 'utc');
+`
+
+	err := s.conn.GetSlice(ctx, &v, query, model.StateTypeDisable)
+
+	return v, err
+}
+
+func (s *Storage) GetVariantsToDeactivate(ctx context.Context) ([]model.Variant, error) {
+	var v []model.Variant
+
+	query := `
+		SELECT id, rule_id, name, data, state, start_at, end_at
+		FROM variant_info
+		WHERE state = $1 AND deleted_at IS NULL
+			AND end_at <= NOW() at time zone 'utc';
+`
+
+	err := s.conn.GetSlice(ctx, &v, query, model.StateTypeEnable)
+
+	return v, err
+}
+
+func (s *Storage) ClearVariantStart(ctx context.Context, id string) error {
+	query := `
+		UPDATE variant_info 
+		SET 
+			start_at = NULL,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id)
+
+	return err
+}
+
+func (s *Storage) ClearVariantEnd(ctx context.Context, id string) error {
+	query := `
+		UPDATE variant_info 
+		SET 
+			start_at = NULL,
+			end_at = NULL,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id)
+
+	return err
+}
+
+func (s *Storage) AddGuardrail(ctx context.Context, g model.Guardrail) (model.Guardrail, error) {
+	query := `
+		INSERT INTO guardrail_info
+		(
+			id, created_at,
+			rule_id, metric, threshold, window_sec, min_count
+		)
+		VALUES
+		(
+			gen_random_uuid(), NOW() at time zone 'utc',
+			$1, $2, $3, $4, $5
+		)
+		RETURNING id;
+`
+
+	var id string
+	err := s.conn.QueryRow(ctx, query, g.RuleId, g.Metric, g.Threshold, g.WindowSec, g.MinCount).Scan(&id)
+
+	g.Id = id
+
+	return g, err
+}
+
+func (s *Storage) GetGuardrails(ctx context.Context, ruleID string) ([]model.Guardrail, error) {
+	var g []model.Guardrail
+
+	query := `
+		SELECT id, rule_id, metric, threshold, window_sec, min_count
+		FROM guardrail_info
+		WHERE rule_id = $1 AND deleted_at IS NULL;
+`
+
+	err := s.conn.GetSlice(ctx, &g, query, ruleID)
+
+	return g, err
+}
+
+func (s *Storage) GetActiveGuardrails(ctx context.Context, services []string) ([]model.Guardrail, error) {
+	var g []model.Guardrail
+
+	query := `
+		SELECT g.id, g.rule_id, g.metric, g.threshold, g.window_sec, g.min_count
+		FROM guardrail_info g
+		JOIN rule_info r ON r.id = g.rule_id
+		WHERE g.deleted_at IS NULL AND r.deleted_at IS NULL AND r.state = $1
+			AND ($2::text[] IS NULL OR r.service = ANY($2));
+`
+
+	err := s.conn.GetSlice(ctx, &g, query, model.StateTypeEnable, services)
+
+	return g, err
+}
+
+func (s *Storage) DeleteGuardrail(ctx context.Context, id string) error {
+	query := `
+		UPDATE guardrail_info 
+		SET 
+			deleted_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id)
+
+	return err
+}
+
+// AddRuleRevision serializes writers of the rule with an advisory lock, otherwise
+// concurrent MAX+1 would collide on UNIQUE(rule_id, revision).
+func (s *Storage) AddRuleRevision(ctx context.Context, ruleID, reason string, snapshot []byte) (uint64, error) {
+	query := `
+		INSERT INTO rule_revision
+		(
+			id, created_at,
+			rule_id, revision, reason, snapshot
+		)
+		SELECT
+			gen_random_uuid(), NOW() at time zone 'utc',
+			$1, COALESCE(MAX(revision), 0) + 1, $2, $3
+		FROM rule_revision
+		WHERE rule_id = $1
+		RETURNING revision;
+`
+
+	var revision uint64
+	err := s.conn.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := s.conn.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('rule_revision:' || $1::text));`, ruleID); err != nil {
+			return errors.Wrap(err, "lock rule revisions")
+		}
+		return s.conn.QueryRow(ctx, query, ruleID, reason, snapshot).Scan(&revision)
+	})
+
+	return revision, err
+}
+
+func (s *Storage) GetRuleRevisions(ctx context.Context, ruleID string) ([]model.RuleRevision, error) {
+	var revisions []model.RuleRevision
+
+	query := `
+		SELECT id, rule_id, revision, reason, snapshot, created_at
+		FROM rule_revision
+		WHERE rule_id = $1
+		ORDER BY revision DESC;
+`
+
+	if err := s.conn.GetSlice(ctx, &revisions, query, ruleID); err != nil {
+		return nil, err
+	}
+
+	for i := range revisions {
+		if err := json.Unmarshal(revisions[i].Snapshot, &revisions[i].Rule); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal snapshot of revision %d", revisions[i].Revision)
+		}
+	}
+
+	return revisions, nil
+}
+
+func (s *Storage) GetRuleRevision(ctx context.Context, ruleID string, revision uint64) (model.RuleRevision, error) {
+	var r model.RuleRevision
+
+	query := `
+		SELECT id, rule_id, revision, reason, snapshot, created_at
+		FROM rule_revision
+		WHERE rule_id = $1 AND revision = $2;
+`
+
+	err := s.conn.GetSingle(ctx, &r, query, ruleID, revision)
+	if len(r.Id) == 0 || errors.Is(err, pgx.ErrNoRows) {
+		return model.RuleRevision{}, ErrNotFound
+	}
+	if err != nil {
+		return model.RuleRevision{}, err
+	}
+
+	if err := json.Unmarshal(r.Snapshot, &r.Rule); err != nil {
+		return model.RuleRevision{}, errors.Wrap(err, "unmarshal snapshot")
+	}
+
+	return r, nil
+}
+
+func (s *Storage) AddAuditEvent(ctx context.Context, e model.AuditEvent) error {
+	query := `
+		INSERT INTO audit_event
+		(
+			id, created_at,
+			actor, method, rule_id, request, prev_state, new_state, error
+		)
+		VALUES
+		(
+			gen_random_uuid(), NOW() at time zone 'utc',
+			$1, $2, NULLIF($3, '')::uuid, NULLIF($4, '')::jsonb, NULLIF($5, '')::jsonb, NULLIF($6, '')::jsonb, $7
+		);
+`
+
+	_, err := s.conn.Exec(ctx, query, e.Actor, e.Method, e.RuleId, e.Request, e.PrevState, e.NewState, e.Error)
+
+	return err
+}
+
+func (s *Storage) ListAuditEvents(ctx context.Context, f model.AuditFilter) ([]model.AuditEvent, error) {
+	var (
+		conds = []string{"TRUE"}
+		args  []any
+	)
+
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if len(f.RuleId) > 0 {
+		add("rule_id = $%d::uuid", f.RuleId)
+	}
+	if len(f.Actor) > 0 {
+		add("actor = $%d", f.Actor)
+	}
+	if f.Services != nil {
+		add("rule_id IN (SELECT id FROM rule_info WHERE service = ANY($%d))", f.Services)
+	}
+	if f.From != nil {
+		add("created_at >= $%d", *f.From)
+	}
+	if f.To != nil {
+		add("created_at < $%d", *f.To)
+	}
+
+	args = append(args, f.Limit)
+	query := fmt.Sprintf(`
+		SELECT id, actor, method, COALESCE(rule_id::text, '') AS rule_id,
+			COALESCE(request::text, '') AS request, COALESCE(prev_state::text, '') AS prev_state,
+			COALESCE(new_state::text, '') AS new_state, error, created_at
+		FROM audit_event
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d;
+`, strings.Join(conds, " AND "), len(args))
+
+	var events []model.AuditEvent
+	err := s.conn.GetSlice(ctx, &events, query, args...)
+
+	return events, err
+}
+
+func (s *Storage) GetGuardrail(ctx context.Context, id string) (model.Guardrail, error) {
+	var g model.Guardrail
+
+	query := `
+		SELECT id, rule_id, metric, threshold, window_sec, min_count
+		FROM guardrail_info
+		WHERE id = $1 AND deleted_at IS NULL;
+`
+
+	err := s.conn.GetSingle(ctx, &g, query, id)
+	if len(g.Id) == 0 || errors.Is(err, pgx.ErrNoRows) {
+		return model.Guardrail{}, ErrNotFound
+	}
+
+	return g, err
+}
+
+const tenantColumns = `name, description, max_rules, max_variants,
+			COALESCE((SELECT array_agg(service ORDER BY service) FROM tenant_service WHERE tenant = t.name), '{}') AS services`
+
+func (s *Storage) CreateTenant(ctx context.Context, t model.Tenant) error {
+	query := `
+		INSERT INTO tenant_info
+		(
+			created_at, updated_at,
+			name, description, max_rules, max_variants
+		)
+		VALUES
+		(
+			NOW() at time zone 'utc', NOW() at time zone 'utc',
+			$1, $2, $3, $4
+		);
+`
+
+	_, err := s.conn.Exec(ctx, query, t.Name, t.Description, t.MaxRules, t.MaxVariants)
+	if isUniqueViolation(err) {
+		return ErrAlreadyExists
+	}
+
+	return err
+}
+
+func (s *Storage) UpdateTenant(ctx context.Context, t model.Tenant) error {
+	query := `
+		UPDATE tenant_info 
+		SET 
+			description = $2,
+			max_rules = $3,
+			max_variants = $4,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE name = $1 AND deleted_at IS NULL;
+`
+
+	_, err := s.conn.Exec(ctx, query, t.Name, t.Description, t.MaxRules, t.MaxVariants)
+
+	return err
+}
+
+func (s *Storage) GetTenant(ctx context.Context, name string) (model.Tenant, error) {
+	var t model.Tenant
+
+	query := `
+		SELECT ` + tenantColumns + `
+		FROM tenant_info t
+		WHERE name = $1 AND deleted_at IS NULL;
+`
+
+	err := s.conn.GetSingle(ctx, &t, query, name)
+	if len(t.Name) == 0 || errors.Is(err, pgx.ErrNoRows) {
+		return model.Tenant{}, ErrNotFound
+	}
+
+	return t, err
+}
+
+// LockTenant holds the tenant row until the end of the transaction in ctx, so
+// quota counts stay valid until the checked insert commits.
+func (s *Storage) LockTenant(ctx context.Context, name string) error {
+	query := `
+		SELECT name
+		FROM tenant_info
+		WHERE name = $1
+		FOR UPDATE;
+`
+
+	_, err := s.conn.Exec(ctx, query, name)
+
+	return err
+}
+
+func (s *Storage) ListTenants(ctx context.Context) ([]model.Tenant, error) {
+	var t []model.Tenant
+
+	query := `
+		SELECT ` + tenantColumns + `
+		FROM tenant_info t
+		WHERE deleted_at IS NULL
+		ORDER BY name;
+`
+
+	err := s.conn.GetSlice(ctx, &t, query)
+
+	return t, err
+}
+
+func (s *Storage) SetTenantServices(ctx context.Context, name string, services []string) error {
+	deleteQuery := `
+		DELETE FROM tenant_service
+		WHERE tenant = $1;
+`
+
+	insertQuery := `
+		INSERT INTO tenant_service (service, tenant, created_at)
+		SELECT unnest($2::text[]), $1, NOW() at time zone 'utc';
+`
+
+	err := s.conn.WrapWithTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, deleteQuery, name); err != nil {
+			return errors.Wrap(err, "delete tenant services")
+		}
+		if _, err := tx.Exec(ctx, insertQuery, name, services); err != nil {
+			return err
+		}
+		return nil
+	})
+	if isUniqueViolation(err) {
+		return ErrAlreadyExists
+	}
+
+	return err
+}
+
+func (s *Storage) GetServiceTenant(ctx context.Context, service string) (string, error) {
+	var tenant string
+
+	query := `
+		SELECT ts.tenant
+		FROM tenant_service ts
+		JOIN tenant_info t ON t.name = ts.tenant
+		WHERE ts.service = $1 AND t.deleted_at IS NULL;
+`
+
+	err := s.conn.GetSingle(ctx, &tenant, query, service)
+	if len(tenant) == 0 || errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrNotFound
+	}
+
+	return tenant, err
+}
+
+func (s *Storage) CountTenantRules(ctx context.Context, name string) (int, error) {
+	var count int
+
+	query := `
+		SELECT COUNT(*)
+		FROM rule_info r
+		JOIN tenant_service ts ON ts.service = r.service
+		WHERE ts.tenant = $1 AND r.deleted_at IS NULL;
+`
+
+	err := s.conn.GetSingle(ctx, &count, query, name)
+
+	return count, err
+}
+
+func (s *Storage) CountTenantVariants(ctx context.Context, name string) (int, error) {
+	var count int
+
+	query := `
+		SELECT COUNT(*)
+		FROM variant_info v
+		JOIN rule_info r ON r.id = v.rule_id
+		JOIN tenant_service ts ON ts.service = r.service
+		WHERE ts.tenant = $1 AND v.deleted_at IS NULL AND r.deleted_at IS NULL;
+`
+
+	err := s.conn.GetSingle(ctx, &count, query, name)
+
+	return count, err
+}
+
+const changeRequestColumns = `id, operation, COALESCE(rule_id::text, '') AS rule_id, service, payload, status,
+			author, reviewer, comment, created_at, updated_at`
+
+func (s *Storage) CreateChangeRequest(ctx context.Context, cr model.ChangeRequest) (model.ChangeRequest, error) {
+	query := `
+		INSERT INTO change_request
+		(
+			id, created_at, updated_at,
+			operation, rule_id, service, payload, status, author
+		)
+		VALUES
+		(
+			gen_random_uuid(), NOW() at time zone 'utc', NOW() at time zone 'utc',
+			$1, NULLIF($2, '')::uuid, $3, $4, $5, $6
+		)
+		RETURNING id;
+`
+
+	var id string
+	err := s.conn.QueryRow(ctx, query, cr.Operation, cr.RuleId, cr.Service, cr.Payload, cr.Status, cr.Author).Scan(&id)
+
+	cr.Id = id
+
+	return cr, err
+}
+
+func (s *Storage) GetChangeRequest(ctx context.Context, id string) (model.ChangeRequest, error) {
+	var cr model.ChangeRequest
+
+	query := `
+		SELECT ` + changeRequestColumns + `
+		FROM change_request
+		WHERE id = $1;
+`
+
+	err := s.conn.GetSingle(ctx, &cr, query, id)
+	if len(cr.Id) == 0 || errors.Is(err, pgx.ErrNoRows) {
+		return model.ChangeRequest{}, ErrNotFound
+	}
+
+	return cr, err
+}
+
+func (s *Storage) ListChangeRequests(ctx context.Context, f model.ChangeRequestFilter) ([]model.ChangeRequest, error) {
+	var (
+		conds = []string{"TRUE"}
+		args  []any
+	)
+
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if len(f.RuleId) > 0 {
+		add("rule_id = $%d::uuid", f.RuleId)
+	}
+	if len(f.Status) > 0 {
+		add("status = $%d", f.Status)
+	}
+	if f.Services != nil {
+		add("service = ANY($%d)", f.Services)
+	}
+
+	args = append(args, f.Limit)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM change_request
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d;
+`, changeRequestColumns, strings.Join(conds, " AND "), len(args))
+
+	var crs []model.ChangeRequest
+	err := s.conn.GetSlice(ctx, &crs, query, args...)
+
+	return crs, err
+}
+
+func (s *Storage) SetChangeRequestStatus(ctx context.Context, id string, from, to model.ChangeStatus, reviewer, comment string) error {
+	query := `
+		UPDATE change_request 
+		SET 
+			status = $3,
+			reviewer = COALESCE(NULLIF($4, ''), reviewer),
+			comment = COALESCE(NULLIF($5, ''), comment),
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1 AND status = $2;
+`
+
+	tag, err := s.conn.Exec(ctx, query, id, from, to, reviewer, comment)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *Storage) SetChangeRequestRule(ctx context.Context, id, ruleID string) error {
+	query := `
+		UPDATE change_request 
+		SET 
+			rule_id = $2,
+			updated_at = NOW() at time zone 'utc' 
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id, ruleID)
+
+	return err
+}
+
+const ruleTemplateColumns = `name, description, bandit_key, COALESCE(variant_schema::text, '') AS variant_schema,
+			priority, targeting, variants, tenant, created_at, updated_at`
+
+func (s *Storage) CreateRuleTemplate(ctx context.Context, t model.RuleTemplate) error {
+	query := `
+		INSERT INTO rule_template
+		(
+			id, created_at, updated_at,
+			name, description, bandit_key, variant_schema, priority, targeting, variants, tenant
+		)
+		VALUES
+		(
+			gen_random_uuid(), NOW() at time zone 'utc', NOW() at time zone 'utc',
+			$1, $2, $3, NULLIF($4, '')::jsonb, $5, $6, $7, $8
+		);
+`
+
+	if t.Targeting == nil {
+		t.Targeting = []model.TargetingCondition{}
+	}
+	if t.Variants == nil {
+		t.Variants = []model.TemplateVariant{}
+	}
+
+	_, err := s.conn.Exec(ctx, query,
+		t.Name, t.Description, t.BanditKey, t.VariantSchema, t.Priority, t.Targeting, t.Variants, t.Tenant,
+	)
+	if isUniqueViolation(err) {
+		return ErrAlreadyExists
+	}
+
+	return err
+}
+
+func (s *Storage) GetRuleTemplate(ctx context.Context, name string) (model.RuleTemplate, error) {
+	var t model.RuleTemplate
+
+	query := `
+		SELECT ` + ruleTemplateColumns + `
+		FROM rule_template
+		WHERE name = $1 AND deleted_at IS NULL;
+`
+
+	err := s.conn.GetSingle(ctx, &t, query, name)
+	if len(t.Name) == 0 || errors.Is(err, pgx.ErrNoRows) {
+		return model.RuleTemplate{}, ErrNotFound
+	}
+
+	return t, err
+}
+
+// ListRuleTemplates returns templates owned by tenants, nil tenants means all.
+func (s *Storage) ListRuleTemplates(ctx context.Context, tenants []string) ([]model.RuleTemplate, error) {
+	var t []model.RuleTemplate
+
+	query := `
+		SELECT ` + ruleTemplateColumns + `
+		FROM rule_template
+		WHERE deleted_at IS NULL AND ($1::text[] IS NULL OR tenant = ANY($1))
+		ORDER BY name;
+`
+
+	err := s.conn.GetSlice(ctx, &t, query, tenants)
+
+	return t, err
+}
+
+func (s *Storage) DeleteRuleTemplate(ctx context.Context, name string) error {
+	query := `
+		UPDATE rule_template 
+		SET 
+			deleted_at = NOW() at time zone 'utc' 
+		WHERE name = $1 AND deleted_at IS NULL;
+`
+
+	_, err := s.conn.Exec(ctx, query, name)
+
+	return err
+}
+
+func (s *Storage) RunInTx(ctx context.Context, exec func(ctx context.Context) error) error {
+	return s.conn.RunInTx(ctx, exec)
+}
+
+func (s *Storage) AddOutboxEvent(ctx context.Context, key string, payload []byte) error {
+	query := `
+		INSERT INTO outbox_event (key, payload, created_at)
+		VALUES ($1, $2, NOW() at time zone 'utc');
+`
+
+	_, err := s.conn.Exec(ctx, query, key, payload)
+
+	return err
+}
+
+const outboxLockID = 7354201
+
+// TryLockOutbox must be called inside RunInTx: the lock is held until the
+// transaction ends, so only one relay publishes at a time and event order is kept.
+func (s *Storage) TryLockOutbox(ctx context.Context) (bool, error) {
+	var locked bool
+
+	err := s.conn.GetSingle(ctx, &locked, `SELECT pg_try_advisory_xact_lock($1);`, outboxLockID)
+
+	return locked, err
+}
+
+func (s *Storage) GetOutboxEvents(ctx context.Context, limit int) ([]model.OutboxEvent, error) {
+	query := `
+		SELECT id, key, payload, attempts, last_error, created_at
+		FROM outbox_event
+		ORDER BY id
+		LIMIT $1;
+`
+
+	var events []model.OutboxEvent
+	err := s.conn.GetSlice(ctx, &events, query, limit)
+
+	return events, err
+}
+
+func (s *Storage) DeleteOutboxEvent(ctx context.Context, id int64) error {
+	query := `
+		DELETE FROM outbox_event
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id)
+
+	return err
+}
+
+func (s *Storage) FailOutboxEvent(ctx context.Context, id int64, reason string) error {
+	query := `
+		UPDATE outbox_event 
+		SET 
+			attempts = attempts + 1,
+			last_error = $2
+		WHERE id = $1;
+`
+
+	_, err := s.conn.Exec(ctx, query, id, reason)
+
+	return err
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
 }
@@ -0,0 +1,121 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrMissingParams = errors.New("missing template params")
+	ErrInvalidData   = errors.New("template data is not valid json")
+)
+
+var placeholder = regexp.MustCompile(`\{\{\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*\}\}`)
+
+// Params returns sorted names of placeholders used in json string values of data.
+func Params(data string) ([]string, error) {
+	var params []string
+
+	_, err := walk(data, func(s string) any {
+		for _, m := range placeholder.FindAllStringSubmatch(s, -1) {
+			if !slices.Contains(params, m[1]) {
+				params = append(params, m[1])
+			}
+		}
+		return s
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(params)
+	return params, nil
+}
+
+// Render substitutes {{name}} placeholders in json string values of data. A string
+// consisting of a single placeholder is replaced with the param decoded as json when
+// possible, so numbers and objects keep their type.
+func Render(data string, params map[string]string) (string, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+
+	var missing []string
+
+	out, err := walk(data, func(s string) any {
+		if m := placeholder.FindStringSubmatch(s); m != nil && m[0] == s {
+			value, ok := params[m[1]]
+			if !ok {
+				missing = append(missing, m[1])
+				return s
+			}
+			var typed any
+			if err := json.Unmarshal([]byte(value), &typed); err == nil {
+				return typed
+			}
+			return value
+		}
+
+		return placeholder.ReplaceAllStringFunc(s, func(p string) string {
+			name := placeholder.FindStringSubmatch(p)[1]
+			value, ok := params[name]
+			if !ok {
+				missing = append(missing, name)
+				return p
+			}
+			return value
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		return "", fmt.Errorf("%w: %s", ErrMissingParams, strings.Join(slices.Compact(missing), ", "))
+	}
+
+	raw, err := json.Marshal(out)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal rendered data")
+	}
+
+	return string(raw), nil
+}
+
+func walk(data string, fn func(s string) any) (any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var v any
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidData, err)
+	}
+
+	return walkValue(v, fn), nil
+}
+
+func walkValue(v any, fn func(s string) any) any {
+	switch t := v.(type) {
+	case string:
+		return fn(t)
+	case []any:
+		for i := range t {
+			t[i] = walkValue(t[i], fn)
+		}
+		return t
+	case map[string]any:
+		for k := range t {
+			t[k] = walkValue(t[k], fn)
+		}
+		return t
+	default:
+		return v
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/EbumbaE/bandit/pkg/auth"
 	"github.com/EbumbaE/bandit/pkg/logger"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	httpSwagger "github.com/swaggo/http-swagger"
@@ -34,7 +35,7 @@ func InitRuleAdminSwagger(ctx context.Context, wg *sync.WaitGroup, swaggerPath,
 		httpSwagger.URL("http://"+swaggerHost+"/swagger.json"),
 	))
 
-	grpcMux := runtime.NewServeMux()
+	grpcMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(auth.GatewayHeaderMatcher))
 	if err := ruleadmin.RegisterRuleAdminServiceHandlerFromEndpoint(ctx, grpcMux, grpcHost, []grpc.DialOption{grpc.WithInsecure()}); err != nil {
 		logger.Error("failed to register gateway handler", zap.Error(err))
 	}
@@ -68,12 +69,12 @@ func InitRuleAdminSwagger(ctx context.Context, wg *sync.WaitGroup, swaggerPath,
 	}()
 }
 
-func StartRuleAdmin(ctx context.Context, serv ruleadmin.RuleAdminServiceServer, wg *sync.WaitGroup, host string) {
+func StartRuleAdmin(ctx context.Context, serv ruleadmin.RuleAdminServiceServer, wg *sync.WaitGroup, host string, opts ...grpc.ServerOption) {
 	listener, err := net.Listen("tcp", host)
 	if err != nil {
 		logger.Error("failed to listen in sender server", zap.Error(err))
 	}
-	server := grpc.NewServer()
+	server := grpc.NewServer(opts...)
 	ruleadmin.RegisterRuleAdminServiceServer(server, serv)
 	reflection.Register(server)
 
@@ -5,13 +5,19 @@ import (
 	"sync"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/EbumbaE/bandit/pkg/auth"
 	"github.com/EbumbaE/bandit/pkg/clickhouse"
+	rule_admin_client "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
 	"github.com/EbumbaE/bandit/pkg/kafka"
 	"github.com/EbumbaE/bandit/pkg/logger"
 	"github.com/EbumbaE/bandit/pkg/psql"
 
+	client_wrapper "github.com/EbumbaE/bandit/services/rule-analytic/internal/client"
 	"github.com/EbumbaE/bandit/services/rule-analytic/internal/consumer"
+	"github.com/EbumbaE/bandit/services/rule-analytic/internal/guardrail"
 	"github.com/EbumbaE/bandit/services/rule-analytic/internal/notifier"
 	"github.com/EbumbaE/bandit/services/rule-analytic/internal/storage"
 )
@@ -33,6 +39,10 @@ type repositories struct {
 	ruleAnalytic *storage.Storage
 }
 
+type clients struct {
+	adminWrapper *client_wrapper.AdminWrapper
+}
+
 type consumers struct {
 	externalEvent kafka.KafkaConsumer
 }
@@ -40,9 +50,11 @@ type consumers struct {
 type application struct {
 	producers    producers
 	notifiers    notifiers
+	clients      clients
 	connections  connections
 	repositories repositories
 	consumers    consumers
+	guardrail    *guardrail.Watcher
 
 	cfg Config
 	wg  *sync.WaitGroup
@@ -54,14 +66,28 @@ func newApp(ctx context.Context, cfg *Config) *application {
 		wg:  &sync.WaitGroup{},
 	}
 
+	a.initClients(ctx)
 	a.initConnections(ctx)
 	a.initProducers(ctx)
 	a.initRepos(ctx)
 	a.initConsumer(ctx)
+	a.initGuardrail()
 
 	return &a
 }
 
+func (a *application) initClients(ctx context.Context) {
+	conn, err := grpc.DialContext(ctx, a.cfg.Service.RuleAdminAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(auth.NewAPIKeyCredentials(a.cfg.Service.RuleAdminApiKey)),
+	)
+	if err != nil {
+		logger.Fatal("connect to rule-admin", zap.Error(err))
+	}
+
+	a.clients.adminWrapper = client_wrapper.NewAdminWrapper(rule_admin_client.NewRuleAdminServiceClient(conn))
+}
+
 func (a *application) initConnections(ctx context.Context) {
 	var err error
 	a.connections.psqlDB, err = psql.NewDatabase(ctx, a.cfg.Postgres.Dsn)
@@ -107,9 +133,19 @@ func (a *application) initConsumer(ctx context.Context) {
 	a.consumers.externalEvent = consumer
 }
 
+func (a *application) initGuardrail() {
+	a.guardrail = guardrail.NewWatcher(a.repositories.ruleAnalytic, a.clients.adminWrapper, a.cfg.Guardrail.Interval)
+}
+
 func (a *application) Run(ctx context.Context) error {
 	go a.consumers.externalEvent.Consume(ctx)
 
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		a.guardrail.Run(ctx)
+	}()
+
 	return nil
 }
 
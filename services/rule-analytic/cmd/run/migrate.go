@@ -0,0 +1,72 @@
+package run
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/EbumbaE/bandit/pkg/clickhouse"
+	"github.com/EbumbaE/bandit/pkg/migration"
+	"github.com/EbumbaE/bandit/pkg/psql"
+
+	"github.com/EbumbaE/bandit/services/rule-analytic/internal/storage"
+)
+
+// Migrate runs schema migrations: migrate -config path [-db postgres|clickhouse] up | down [-steps n] | status.
+// Without -db up and status run for both databases, down needs the database.
+func Migrate(args []string) {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	configPath := fs.String("config", "", "config path")
+	db := fs.String("db", "", "postgres or clickhouse, both when empty")
+	_ = fs.Parse(args)
+
+	if *db != "" && *db != "postgres" && *db != "clickhouse" {
+		log.Fatalf("unknown database %q", *db)
+	}
+	if *db == "" && fs.Arg(0) == "down" {
+		log.Fatal("migrate down: -db is required")
+	}
+
+	config := readConfig(*configPath)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	psqlDB, err := psql.NewDatabase(ctx, config.Postgres.Dsn)
+	if err != nil {
+		log.Fatal("connect to postgres: ", err)
+	}
+	defer psqlDB.Close()
+
+	clickDB, err := clickhouse.NewDatabase(ctx, config.ClickHouse.Dsn)
+	if err != nil {
+		log.Fatal("connect to clickhouse: ", err)
+	}
+	defer clickDB.Close()
+
+	psqlMigrator, clickMigrator, err := storage.NewMigrators(psqlDB, clickDB)
+	if err != nil {
+		log.Fatal("load migrations: ", err)
+	}
+
+	for _, target := range []struct {
+		name     string
+		migrator *migration.Migrator
+	}{
+		{name: "postgres", migrator: psqlMigrator},
+		{name: "clickhouse", migrator: clickMigrator},
+	} {
+		if *db != "" && *db != target.name {
+			continue
+		}
+
+		fmt.Printf("%s:\n", target.name)
+		if err := migration.Command(ctx, target.migrator, fs.Args(), os.Stdout); err != nil {
+			log.Fatalf("%s: %s", target.name, err)
+		}
+	}
+}
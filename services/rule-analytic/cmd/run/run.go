@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v2"
@@ -57,9 +58,17 @@ func readConfig(configPath string) *Config {
 }
 
 type Config struct {
-	Postgres   Postgres   `yaml:"postgres"`
-	ClickHouse ClickHouse `yaml:"clickhouse"`
-	Kafka      Kafka      `yaml:"kafka"`
+	Service    RuleAnalyticService `yaml:"service"`
+	Postgres   Postgres            `yaml:"postgres"`
+	ClickHouse ClickHouse          `yaml:"clickhouse"`
+	Kafka      Kafka               `yaml:"kafka"`
+	Guardrail  Guardrail           `yaml:"guardrail"`
+}
+
+type RuleAnalyticService struct {
+	RuleAdminAddress  string        `yaml:"rule_admin_address"`
+	RuleAdminApiKey   string        `yaml:"rule_admin_api_key"`
+	ConnectionTimeout time.Duration `yaml:"connection_timeout"`
 }
 
 type Postgres struct {
@@ -75,3 +84,7 @@ type Kafka struct {
 	ExternalTopic string   `yaml:"external_topic"`
 	InternalTopic string   `yaml:"internal_topic"`
 }
+
+type Guardrail struct {
+	Interval time.Duration `yaml:"interval"`
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
+	model "github.com/EbumbaE/bandit/services/rule-analytic/internal"
+)
+
+type AdminClient interface {
+	GetRule(ctx context.Context, in *pb.GetRuleRequest, opts ...grpc.CallOption) (*pb.RuleResponse, error)
+	SetVariantState(ctx context.Context, in *pb.SetVariantStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListGuardrails(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.GetGuardrailsResponse, error)
+}
+
+type AdminWrapper struct {
+	client AdminClient
+}
+
+func NewAdminWrapper(client AdminClient) *AdminWrapper {
+	return &AdminWrapper{
+		client: client,
+	}
+}
+
+func (w *AdminWrapper) ListGuardrails(ctx context.Context) ([]model.Guardrail, error) {
+	resp, err := w.client.ListGuardrails(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]model.Guardrail, 0, len(resp.GetGuardrails()))
+	for _, g := range resp.GetGuardrails() {
+		res = append(res, model.Guardrail{
+			Id:        g.GetId(),
+			RuleID:    g.GetRuleId(),
+			Metric:    decodeGuardrailMetric(g.GetMetric()),
+			Threshold: g.GetThreshold(),
+			Window:    g.GetWindow().AsDuration(),
+			MinCount:  g.GetMinCount(),
+		})
+	}
+
+	return res, nil
+}
+
+func (w *AdminWrapper) GetEnabledVariants(ctx context.Context, ruleID string) ([]string, error) {
+	resp, err := w.client.GetRule(ctx, &pb.GetRuleRequest{Id: ruleID})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(resp.GetRule().GetVariants()))
+	for _, v := range resp.GetRule().GetVariants() {
+		if v.GetState() == pb.State_STATE_ENABLED {
+			res = append(res, v.GetId())
+		}
+	}
+
+	return res, nil
+}
+
+func (w *AdminWrapper) DisableVariant(ctx context.Context, ruleID, variantID string) error {
+	_, err := w.client.SetVariantState(ctx, &pb.SetVariantStateRequest{
+		Id:     variantID,
+		RuleId: ruleID,
+		State:  pb.State_STATE_DISABLED,
+	})
+	return err
+}
+
+func decodeGuardrailMetric(v pb.GuardrailMetric) model.GuardrailMetric {
+	switch v {
+	case pb.GuardrailMetric_GUARDRAIL_METRIC_ERROR_RATE:
+		return model.GuardrailMetricErrorRate
+	case pb.GuardrailMetric_GUARDRAIL_METRIC_MIN_REWARD:
+		return model.GuardrailMetricMinReward
+	default:
+		return ""
+	}
+}
@@ -109,18 +109,7 @@ func (c *Consumer) Handle(ctx context.Context, msg []byte) error {
 }
 
 func calculateReward(history model.HistoryEvent) float64 {
-	switch history.Action {
-	case model.ClickActionType:
-		return history.Amount * 0.3
-	case model.ViewActionType:
-		return history.Amount * 0.1
-	case model.CartActionType:
-		return history.Amount * 0.003
-	case model.PurchaseActionType:
-		return history.Amount * 0.3
-	default:
-		return 0
-	}
+	return history.Action.Reward(history.Amount)
 }
 
 func (c *Consumer) historyBatcher() {
@@ -0,0 +1,177 @@
+package guardrail
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/EbumbaE/bandit/pkg/logger"
+	model "github.com/EbumbaE/bandit/services/rule-analytic/internal"
+)
+
+type Storage interface {
+	GetVariantActionStats(ctx context.Context, ruleID string, since time.Time) ([]model.VariantActionStat, error)
+	SaveGuardrailBreach(ctx context.Context, breach model.GuardrailBreach) error
+}
+
+type Admin interface {
+	ListGuardrails(ctx context.Context) ([]model.Guardrail, error)
+	GetEnabledVariants(ctx context.Context, ruleID string) ([]string, error)
+	DisableVariant(ctx context.Context, ruleID, variantID string) error
+}
+
+type Watcher struct {
+	storage  Storage
+	admin    Admin
+	interval time.Duration
+}
+
+const defaultInterval = 30 * time.Second
+
+// NewWatcher falls back to the default for a non-positive interval, time.NewTicker
+// panics on a zero one.
+func NewWatcher(storage Storage, admin Admin, interval time.Duration) *Watcher {
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	return &Watcher{
+		storage:  storage,
+		admin:    admin,
+		interval: interval,
+	}
+}
+
+func (w *Watcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := w.evaluate(ctx); err != nil {
+				logger.Error("evaluate guardrails", zap.Error(err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+type variantStat struct {
+	count  uint64
+	errors uint64
+	reward float64
+}
+
+func (w *Watcher) evaluate(ctx context.Context) error {
+	guardrails, err := w.admin.ListGuardrails(ctx)
+	if err != nil {
+		return errors.Wrap(err, "admin.ListGuardrails")
+	}
+
+	byRule := make(map[string][]model.Guardrail)
+	for _, g := range guardrails {
+		byRule[g.RuleID] = append(byRule[g.RuleID], g)
+	}
+
+	for ruleID, ruleGuardrails := range byRule {
+		if err := w.evaluateRule(ctx, ruleID, ruleGuardrails); err != nil {
+			logger.Error("evaluate rule guardrails", zap.String("rule_id", ruleID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+func (w *Watcher) evaluateRule(ctx context.Context, ruleID string, guardrails []model.Guardrail) error {
+	variants, err := w.admin.GetEnabledVariants(ctx, ruleID)
+	if err != nil {
+		return errors.Wrap(err, "admin.GetEnabledVariants")
+	}
+
+	disabled := make(map[string]struct{})
+	for _, g := range guardrails {
+		stats, err := w.collectStats(ctx, ruleID, g.Window)
+		if err != nil {
+			return err
+		}
+
+		for _, variantID := range variants {
+			if _, ok := disabled[variantID]; ok {
+				continue
+			}
+
+			stat, ok := stats[variantID]
+			if !ok || stat.count == 0 || stat.count < g.MinCount {
+				continue
+			}
+
+			value, breached := check(g, stat)
+			if !breached {
+				continue
+			}
+
+			breach := model.GuardrailBreach{
+				GuardrailID: g.Id,
+				RuleID:      ruleID,
+				VariantID:   variantID,
+				Metric:      string(g.Metric),
+				Value:       value,
+				Threshold:   g.Threshold,
+				Reason: fmt.Sprintf("guardrail %s breached: %s=%.4f, threshold=%.4f, window=%s, events=%d",
+					g.Id, g.Metric, value, g.Threshold, g.Window, stat.count),
+			}
+
+			if err := w.admin.DisableVariant(ctx, ruleID, variantID); err != nil {
+				logger.Error("disable variant", zap.String("rule_id", ruleID), zap.String("variant_id", variantID), zap.Error(err))
+				continue
+			}
+			disabled[variantID] = struct{}{}
+
+			logger.Info("variant disabled by guardrail", zap.String("rule_id", ruleID), zap.String("variant_id", variantID), zap.String("reason", breach.Reason))
+
+			if err := w.storage.SaveGuardrailBreach(ctx, breach); err != nil {
+				logger.Error("save guardrail breach", zap.Error(err))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *Watcher) collectStats(ctx context.Context, ruleID string, window time.Duration) (map[string]variantStat, error) {
+	rows, err := w.storage.GetVariantActionStats(ctx, ruleID, time.Now().UTC().Add(-window))
+	if err != nil {
+		return nil, errors.Wrap(err, "storage.GetVariantActionStats")
+	}
+
+	stats := make(map[string]variantStat)
+	for _, row := range rows {
+		stat := stats[row.VariantID]
+		stat.count += row.Count
+		stat.reward += row.Action.Reward(row.Amount)
+		if row.Action == model.ErrorActionType {
+			stat.errors += row.Count
+		}
+		stats[row.VariantID] = stat
+	}
+
+	return stats, nil
+}
+
+func check(g model.Guardrail, stat variantStat) (float64, bool) {
+	switch g.Metric {
+	case model.GuardrailMetricErrorRate:
+		rate := float64(stat.errors) / float64(stat.count)
+		return rate, rate > g.Threshold
+	case model.GuardrailMetricMinReward:
+		mean := stat.reward / float64(stat.count)
+		return mean, mean < g.Threshold
+	default:
+		return 0, false
+	}
+}
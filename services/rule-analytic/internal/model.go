@@ -1,5 +1,7 @@
 package internal
 
+import "time"
+
 type PayloadAnalitic struct {
 	Service     string `json:"service"`
 	Context     string `json:"context"`
@@ -29,8 +31,57 @@ var (
 	ViewActionType     = ActionType("view")
 	CartActionType     = ActionType("add_to_cart")
 	PurchaseActionType = ActionType("purchase")
+	ErrorActionType    = ActionType("error")
 )
 
 func (a ActionType) String() string {
 	return string(a)
 }
+
+func (a ActionType) Reward(amount float64) float64 {
+	switch a {
+	case ClickActionType:
+		return amount * 0.3
+	case ViewActionType:
+		return amount * 0.1
+	case CartActionType:
+		return amount * 0.003
+	case PurchaseActionType:
+		return amount * 0.3
+	default:
+		return 0
+	}
+}
+
+type GuardrailMetric string
+
+var (
+	GuardrailMetricErrorRate GuardrailMetric = "error_rate"
+	GuardrailMetricMinReward GuardrailMetric = "min_reward"
+)
+
+type Guardrail struct {
+	Id        string
+	RuleID    string
+	Metric    GuardrailMetric
+	Threshold float64
+	Window    time.Duration
+	MinCount  uint64
+}
+
+type VariantActionStat struct {
+	VariantID string     `db:"variant_id"`
+	Action    ActionType `db:"action"`
+	Count     uint64     `db:"count"`
+	Amount    float64    `db:"amount"`
+}
+
+type GuardrailBreach struct {
+	GuardrailID string  `db:"guardrail_id"`
+	RuleID      string  `db:"rule_id"`
+	VariantID   string  `db:"variant_id"`
+	Metric      string  `db:"metric"`
+	Value       float64 `db:"value"`
+	Threshold   float64 `db:"threshold"`
+	Reason      string  `db:"reason"`
+}
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS full_analytic_info (
+    service       String,
+    context       String,
+    rule_id       UUID,
+    variant_id    UUID,
+    rule_version  UInt64,
+    action        String,
+    amount        Float64,
+    created_at    DateTime DEFAULT now()
+) ENGINE = MergeTree()
+ORDER BY (created_at, rule_id, variant_id);
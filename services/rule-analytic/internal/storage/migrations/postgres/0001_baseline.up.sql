@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS analytic_info (
+    rule_id UUID,
+    variant_id UUID,
+
+    reward DOUBLE PRECISION,
+    rule_version BIGINT,
+    count BIGINT,
+
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS analytic_info_rule_id_variant_id ON analytic_info(rule_id, variant_id, rule_version);
+
+CREATE TABLE IF NOT EXISTS guardrail_audit (
+    id BIGSERIAL PRIMARY KEY,
+    guardrail_id UUID NOT NULL,
+    rule_id UUID NOT NULL,
+    variant_id UUID NOT NULL,
+
+    metric TEXT NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    threshold DOUBLE PRECISION NOT NULL,
+    reason TEXT NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS guardrail_audit_rule_id ON guardrail_audit(rule_id);
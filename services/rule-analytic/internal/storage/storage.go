@@ -3,12 +3,14 @@ package storage
 import (
 	"context"
 	"database/sql"
-	"fmt"
+	"embed"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 
 	"github.com/EbumbaE/bandit/pkg/clickhouse"
+	"github.com/EbumbaE/bandit/pkg/migration"
 	"github.com/EbumbaE/bandit/pkg/psql"
 
 	model "github.com/EbumbaE/bandit/services/rule-analytic/internal"
@@ -16,65 +18,48 @@ import (
 
 var ErrNotFound = pgx.ErrNoRows
 
+//go:embed migrations
+var migrationFiles embed.FS
+
 type Storage struct {
 	psqlDB  psql.Database
 	clickDB clickhouse.Database
 }
 
 func New(ctx context.Context, psqlDB psql.Database, clickDB clickhouse.Database) (*Storage, error) {
-	if err := initPsqlSchema(ctx, psqlDB); err != nil {
-		return nil, errors.Wrap(err, "init psql schema")
+	psqlMigrator, clickMigrator, err := NewMigrators(psqlDB, clickDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "load migrations")
+	}
+	if _, err := psqlMigrator.Up(ctx); err != nil {
+		return nil, errors.Wrap(err, "migrate psql schema")
 	}
-	if err := initClickSchema(ctx, clickDB); err != nil {
-		return nil, errors.Wrap(err, "init click schema")
+	if _, err := clickMigrator.Up(ctx); err != nil {
+		return nil, errors.Wrap(err, "migrate click schema")
 	}
+
 	return &Storage{
 		psqlDB:  psqlDB,
 		clickDB: clickDB,
 	}, nil
 }
 
-func initPsqlSchema(ctx context.Context, db psql.Database) error {
-	query := `
-		CREATE TABLE IF NOT EXISTS analytic_info (
-			rule_id UUID,
-			variant_id UUID,
-
-			reward DOUBLE PRECISION,
-			rule_version BIGINT,
-			count BIGINT,
-
-			created_at TIMESTAMP NOT NULL DEFAULT now(),
-			updated_at TIMESTAMP NOT NULL DEFAULT now()
-		);
-		
-		CREATE UNIQUE INDEX IF NOT EXISTS analytic_info_rule_id_variant_id ON analytic_info(rule_id, variant_id, rule_version);
-`
-
-	_, err := db.Exec(ctx, query)
-	return err
-}
-
-func initClickSchema(ctx context.Context, db clickhouse.Database) error {
-	query := `
-		CREATE TABLE IF NOT EXISTS full_analytic_info (
-			service       String,
-			context       String,
-			rule_id       UUID,
-			variant_id    UUID,
-			rule_version  UInt64,
-			action        String,
-			amount        Float64,
-			created_at    DateTime DEFAULT now()
-		) ENGINE = MergeTree()
-		ORDER BY (created_at, rule_id, variant_id);
-	`
+// NewMigrators returns migrators of the postgres and clickhouse schemas, New applies
+// pending migrations. ClickHouse runners are serialized by a postgres advisory lock.
+func NewMigrators(psqlDB psql.Database, clickDB clickhouse.Database) (*migration.Migrator, *migration.Migrator, error) {
+	psqlMigrations, err := migration.Load(migrationFiles, "migrations/postgres")
+	if err != nil {
+		return nil, nil, err
+	}
 
-	_, err := db.Exec(ctx, query)
+	clickMigrations, err := migration.Load(migrationFiles, "migrations/clickhouse")
 	if err != nil {
-		return fmt.Errorf("failed to create table: %w", err)
+		return nil, nil, err
 	}
-	return nil
+
+	clickLocker := psql.NewMigrationDriver(psqlDB, "clickhouse_"+clickhouse.SchemaVersionTable)
+
+	return psql.NewMigrator(psqlDB, psqlMigrations), clickhouse.NewMigrator(clickDB, clickLocker, clickMigrations), nil
 }
 
 func (s *Storage) ApplyAnalyticEvent(ctx context.Context, events []model.BanditEvent) error {
@@ -175,3 +160,44 @@ func (s *Storage) InsertHistoryBatch(ctx context.Context, batch []model.HistoryE
 		},
 	)
 }
+
+func (s *Storage) GetVariantActionStats(ctx context.Context, ruleID string, since time.Time) ([]model.VariantActionStat, error) {
+	query := `
+		SELECT 
+			toString(variant_id) AS variant_id, action,
+			count() AS count, sum(amount) AS amount
+		FROM full_analytic_info
+		WHERE rule_id = ? AND created_at >= ?
+		GROUP BY variant_id, action;
+	`
+
+	var stats []model.VariantActionStat
+	if err := s.clickDB.GetSlice(ctx, &stats, query, ruleID, since); err != nil {
+		return nil, errors.Wrap(err, "query variant action stats")
+	}
+
+	return stats, nil
+}
+
+func (s *Storage) SaveGuardrailBreach(ctx context.Context, breach model.GuardrailBreach) error {
+	query := `
+		INSERT INTO guardrail_audit
+			(created_at, guardrail_id, rule_id, variant_id, metric, value, threshold, reason)
+		VALUES (
+			NOW() at time zone 'utc',
+			$1, $2, $3, $4, $5, $6, $7
+		);
+`
+
+	_, err := s.psqlDB.Exec(ctx, query,
+		breach.GuardrailID,
+		breach.RuleID,
+		breach.VariantID,
+		breach.Metric,
+		breach.Value,
+		breach.Threshold,
+		breach.Reason,
+	)
+
+	return err
+}
@@ -14,7 +14,7 @@ import (
 )
 
 type DillerProvider interface {
-	GetRuleData(ctx context.Context, service, ctxKey string) (string, string, error)
+	GetRuleData(ctx context.Context, service, ctxKey, unitID string, attributes map[string]string) (string, string, error)
 	GetRuleStatistic(ctx context.Context, service, ctxKey string) ([]model.Variant, error)
 }
 
@@ -38,9 +38,12 @@ func (i *Implementation) GetRuleData(ctx context.Context, req *desc.GetRuleReque
 		return nil, status.Error(codes.InvalidArgument, "empty service or context")
 	}
 
-	ruleData, payload, err := i.dillerProvider.GetRuleData(ctx, req.GetService(), req.GetContext())
+	ruleData, payload, err := i.dillerProvider.GetRuleData(ctx, req.GetService(), req.GetContext(), req.GetUnitId(), req.GetAttributes())
 	if err != nil {
-		if errors.Is(err, provider.ErrEmptyAnswer) {
+		if errors.Is(err, provider.ErrUnitRequired) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, provider.ErrEmptyAnswer) || errors.Is(err, provider.ErrNoRule) || errors.Is(err, provider.ErrNotInLayer) {
 			return nil, status.Error(codes.FailedPrecondition, err.Error())
 		}
 		return nil, status.Error(codes.Internal, err.Error())
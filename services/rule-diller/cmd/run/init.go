@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/EbumbaE/bandit/pkg/auth"
 	bandit_indexer_client "github.com/EbumbaE/bandit/pkg/genproto/bandit-indexer/api"
 	rule_admin_client "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
 	"github.com/EbumbaE/bandit/pkg/kafka"
@@ -68,14 +69,20 @@ func newApp(ctx context.Context, cfg *Config) *application {
 }
 
 func (a *application) initClients(ctx context.Context) {
-	conn, err := grpc.DialContext(ctx, a.cfg.Service.BanditIndexerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.DialContext(ctx, a.cfg.Service.BanditIndexerAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(auth.NewAPIKeyCredentials(a.cfg.Service.BanditIndexerApiKey)),
+	)
 	if err != nil {
 		logger.Fatal("connect to bandit-indexer", zap.Error(err))
 	}
 
 	a.clients.indexerWrapper = client_wrapper.NewIndexerWrapper(bandit_indexer_client.NewBanditIndexerServiceClient(conn))
 
-	conn, err = grpc.DialContext(ctx, a.cfg.Service.RuleAdminAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err = grpc.DialContext(ctx, a.cfg.Service.RuleAdminAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(auth.NewAPIKeyCredentials(a.cfg.Service.RuleAdminApiKey)),
+	)
 	if err != nil {
 		logger.Fatal("connect to rule-admin", zap.Error(err))
 	}
@@ -105,6 +112,9 @@ func (a *application) initProvider() {
 
 func (a *application) initConsumer(ctx context.Context) {
 	handler := consumer.NewConsumer(a.clients.indexerWrapper, a.clients.adminWrapper, a.repositories.ruleDiller)
+	if err := handler.Backfill(ctx); err != nil {
+		logger.Error("backfill legacy rules", zap.Error(err))
+	}
 
 	consumer, err := kafka.NewKafkaConsumer(ctx, a.cfg.Kafka.Brokers, a.cfg.Kafka.Topic, handler.Handle, nil)
 	if err != nil {
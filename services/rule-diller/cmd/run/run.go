@@ -69,7 +69,9 @@ type RuleAdminService struct {
 	GrpcAddress          string        `yaml:"rule_diller_address"`
 	SwaggerHost          string        `yaml:"swagger_host"`
 	BanditIndexerAddress string        `yaml:"bandit_indexer_address"`
+	BanditIndexerApiKey  string        `yaml:"bandit_indexer_api_key"`
 	RuleAdminAddress     string        `yaml:"rule_admin_address"`
+	RuleAdminApiKey      string        `yaml:"rule_admin_api_key"`
 	ConnectionTimeout    time.Duration `yaml:"connection_timeout"`
 }
 
@@ -5,11 +5,14 @@ import (
 
 	pb "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
 	"google.golang.org/grpc"
+
+	model "github.com/EbumbaE/bandit/services/rule-diller/internal"
 )
 
 type AdminClient interface {
 	GetRuleServiceContext(ctx context.Context, in *pb.GetRuleRequest, opts ...grpc.CallOption) (*pb.GetRuleServiceContextResponse, error)
 	GetVariantData(ctx context.Context, in *pb.GetVariantRequest, opts ...grpc.CallOption) (*pb.VariantResponse, error)
+	GetLayer(ctx context.Context, in *pb.GetLayerRequest, opts ...grpc.CallOption) (*pb.LayerResponse, error)
 }
 
 type AdminWrapper struct {
@@ -22,12 +25,60 @@ func NewAdminWrapper(client AdminClient) *AdminWrapper {
 	}
 }
 
-func (i *AdminWrapper) GetRuleServiceContext(ctx context.Context, ruleID string) (string, string, error) {
+func (i *AdminWrapper) GetRuleMeta(ctx context.Context, ruleID string) (model.Rule, error) {
 	resp, err := i.client.GetRuleServiceContext(ctx, &pb.GetRuleRequest{Id: ruleID})
-	return resp.GetService(), resp.GetContext(), err
+	if err != nil {
+		return model.Rule{}, err
+	}
+
+	return model.Rule{
+		ID:        ruleID,
+		Service:   resp.GetService(),
+		Context:   resp.GetContext(),
+		LayerID:   resp.GetLayerId(),
+		Priority:  resp.GetPriority(),
+		Targeting: decodeTargeting(resp.GetTargeting()),
+		Active:    resp.GetState() == pb.State_STATE_ENABLED,
+	}, nil
+}
+
+func (i *AdminWrapper) GetLayerRules(ctx context.Context, layerID string) ([]string, error) {
+	resp, err := i.client.GetLayer(ctx, &pb.GetLayerRequest{Id: layerID})
+	return resp.GetLayer().GetActiveRuleIds(), err
 }
 
 func (i *AdminWrapper) GetVariantData(ctx context.Context, ruleID string, variantID string) (string, error) {
 	resp, err := i.client.GetVariantData(ctx, &pb.GetVariantRequest{Id: variantID, RuleId: ruleID})
 	return resp.GetVariant().GetData(), err
 }
+
+func decodeTargeting(in []*pb.TargetingCondition) []model.TargetingCondition {
+	res := make([]model.TargetingCondition, len(in))
+
+	for i, c := range in {
+		res[i] = model.TargetingCondition{
+			Attribute: c.GetAttribute(),
+			Operator:  decodeTargetingOperator(c.GetOperator()),
+			Values:    c.GetValues(),
+		}
+	}
+
+	return res
+}
+
+func decodeTargetingOperator(v pb.TargetingOperator) model.TargetingOperator {
+	switch v {
+	case pb.TargetingOperator_TARGETING_OPERATOR_EQ:
+		return model.TargetingOperatorEq
+	case pb.TargetingOperator_TARGETING_OPERATOR_IN:
+		return model.TargetingOperatorIn
+	case pb.TargetingOperator_TARGETING_OPERATOR_NOT_IN:
+		return model.TargetingOperatorNotIn
+	case pb.TargetingOperator_TARGETING_OPERATOR_VERSION_GTE:
+		return model.TargetingOperatorVersionGte
+	case pb.TargetingOperator_TARGETING_OPERATOR_VERSION_LT:
+		return model.TargetingOperatorVersionLt
+	default:
+		return ""
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 
-	model "github.com/EbumbaE/bandit/services/rule-diller/internal"
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/EbumbaE/bandit/pkg/logger"
+	model "github.com/EbumbaE/bandit/services/rule-diller/internal"
 )
 
 type Indexer interface {
@@ -13,13 +16,22 @@ type Indexer interface {
 }
 
 type Admin interface {
-	GetRuleServiceContext(ctx context.Context, ruleID string) (string, string, error)
+	GetRuleMeta(ctx context.Context, ruleID string) (model.Rule, error)
 	GetVariantData(ctx context.Context, ruleID string, variantID string) (string, error)
+	GetLayerRules(ctx context.Context, layerID string) ([]string, error)
 }
 
 type Storage interface {
+	SaveRuleInfo(ctx context.Context, rule model.Rule) error
 	SaveRuleVariants(ctx context.Context, service, context, ruleID string, variants []model.Variant) error
-	SaveRuleVersion(ctx context.Context, service, context string, version uint64) error
+	SaveRuleVersion(ctx context.Context, service, context, ruleID string, version uint64) error
+	RemoveRule(ctx context.Context, service, context, ruleID string) error
+
+	GetRuleLayer(ctx context.Context, service, context, ruleID string) (string, error)
+	SaveLayerRules(ctx context.Context, layerID string, ruleIDs []string) error
+
+	GetLegacyRules(ctx context.Context) ([]model.Rule, error)
+	RemoveLegacyRule(ctx context.Context, service, context string) error
 }
 
 type Consumer struct {
@@ -38,6 +50,7 @@ func NewConsumer(indexer Indexer, admin Admin, storage Storage) *Consumer {
 
 type Event struct {
 	RuleID string `json:"rule_id"`
+	Action string `json:"action"`
 }
 
 func (c *Consumer) Handle(ctx context.Context, msg []byte) error {
@@ -46,16 +59,60 @@ func (c *Consumer) Handle(ctx context.Context, msg []byte) error {
 		return errors.Wrapf(err, "unmarshal message: %s", string(msg))
 	}
 
-	rule, err := c.indexer.GetRule(ctx, event.RuleID)
+	return c.refresh(ctx, *event)
+}
+
+// Backfill moves rules of the legacy per-context layout to per-rule keys: every
+// rule is reloaded from rule-admin and the indexer, then its legacy keys are
+// dropped. Rules that fail keep legacy keys and are retried on the next start.
+func (c *Consumer) Backfill(ctx context.Context) error {
+	rules, err := c.storage.GetLegacyRules(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "GetRule for rule[%s]", event.RuleID)
+		return errors.Wrap(err, "GetLegacyRules")
 	}
 
-	rule.Service, rule.Context, err = c.admin.GetRuleServiceContext(ctx, event.RuleID)
+	for _, r := range rules {
+		if len(r.ID) > 0 {
+			if err := c.refresh(ctx, Event{RuleID: r.ID}); err != nil {
+				logger.Error("backfill legacy rule", zap.String("rule_id", r.ID), zap.Error(err))
+				continue
+			}
+		}
+
+		if err := c.storage.RemoveLegacyRule(ctx, r.Service, r.Context); err != nil {
+			return errors.Wrapf(err, "RemoveLegacyRule for service[%s], context[%s]", r.Service, r.Context)
+		}
+		logger.Info("legacy rule backfilled", zap.String("service", r.Service), zap.String("context", r.Context), zap.String("rule_id", r.ID))
+	}
+
+	return nil
+}
+
+func (c *Consumer) refresh(ctx context.Context, event Event) error {
+	meta, err := c.admin.GetRuleMeta(ctx, event.RuleID)
 	if err != nil {
-		return errors.Wrapf(err, "GetRuleServiceContext for rule[%s]", event.RuleID)
+		return errors.Wrapf(err, "GetRuleMeta for rule[%s]", event.RuleID)
 	}
 
+	if err := c.refreshLayer(ctx, event.RuleID, meta); err != nil {
+		return errors.Wrapf(err, "refreshLayer for rule[%s]", event.RuleID)
+	}
+
+	if event.Action == "delete" || !meta.Active {
+		if err := c.storage.RemoveRule(ctx, meta.Service, meta.Context, event.RuleID); err != nil {
+			return errors.Wrapf(err, "RemoveRule for rule[%s]", event.RuleID)
+		}
+		return nil
+	}
+
+	rule, err := c.indexer.GetRule(ctx, event.RuleID)
+	if err != nil {
+		return errors.Wrapf(err, "GetRule for rule[%s]", event.RuleID)
+	}
+
+	rule.ID, rule.Service, rule.Context = event.RuleID, meta.Service, meta.Context
+	rule.LayerID, rule.Priority, rule.Targeting = meta.LayerID, meta.Priority, meta.Targeting
+
 	for i, v := range rule.Variants {
 		rule.Variants[i].Data, err = c.admin.GetVariantData(ctx, event.RuleID, v.Key)
 		if err != nil {
@@ -66,9 +123,41 @@ func (c *Consumer) Handle(ctx context.Context, msg []byte) error {
 	if err = c.storage.SaveRuleVariants(ctx, rule.Service, rule.Context, event.RuleID, rule.Variants); err != nil {
 		return errors.Wrapf(err, "SaveRuleVariants for service[%s], context[%s], variants[%v]", rule.Service, rule.Context, rule.Variants)
 	}
-	if err = c.storage.SaveRuleVersion(ctx, rule.Service, rule.Context, rule.Version); err != nil {
+	if err = c.storage.SaveRuleVersion(ctx, rule.Service, rule.Context, event.RuleID, rule.Version); err != nil {
 		return errors.Wrapf(err, "SaveRuleVersion for service[%s], context[%s], variants[%v]", rule.Service, rule.Context, rule.Version)
 	}
+	if err = c.storage.SaveRuleInfo(ctx, rule); err != nil {
+		return errors.Wrapf(err, "SaveRuleInfo for service[%s], context[%s], rule[%s]", rule.Service, rule.Context, event.RuleID)
+	}
+
+	return nil
+}
+
+func (c *Consumer) refreshLayer(ctx context.Context, ruleID string, meta model.Rule) error {
+	prevLayerID, err := c.storage.GetRuleLayer(ctx, meta.Service, meta.Context, ruleID)
+	if err != nil {
+		return errors.Wrap(err, "GetRuleLayer")
+	}
+
+	layers := []string{meta.LayerID}
+	if prevLayerID != meta.LayerID {
+		layers = append(layers, prevLayerID)
+	}
+
+	for _, layerID := range layers {
+		if len(layerID) == 0 {
+			continue
+		}
+
+		ruleIDs, err := c.admin.GetLayerRules(ctx, layerID)
+		if err != nil {
+			return errors.Wrapf(err, "GetLayerRules for layer[%s]", layerID)
+		}
+
+		if err := c.storage.SaveLayerRules(ctx, layerID, ruleIDs); err != nil {
+			return errors.Wrapf(err, "SaveLayerRules for layer[%s]", layerID)
+		}
+	}
 
 	return nil
 }
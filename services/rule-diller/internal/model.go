@@ -17,8 +17,29 @@ type PayloadAnalitic struct {
 }
 
 type Rule struct {
-	Service  string
-	Context  string
-	Variants []Variant
-	Version  uint64
+	ID        string
+	Service   string
+	Context   string
+	LayerID   string
+	Priority  int32
+	Targeting []TargetingCondition
+	Active    bool
+	Variants  []Variant
+	Version   uint64
+}
+
+type TargetingOperator string
+
+var (
+	TargetingOperatorEq         TargetingOperator = "eq"
+	TargetingOperatorIn         TargetingOperator = "in"
+	TargetingOperatorNotIn      TargetingOperator = "not_in"
+	TargetingOperatorVersionGte TargetingOperator = "version_gte"
+	TargetingOperatorVersionLt  TargetingOperator = "version_lt"
+)
+
+type TargetingCondition struct {
+	Attribute string            `json:"attribute"`
+	Operator  TargetingOperator `json:"operator"`
+	Values    []string          `json:"values"`
 }
@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"hash/fnv"
+	"sort"
 
 	"github.com/EbumbaE/bandit/pkg/logger"
 	"github.com/EbumbaE/bandit/services/bandit-core/v6"
@@ -10,17 +12,25 @@ import (
 	"go.uber.org/zap"
 
 	model "github.com/EbumbaE/bandit/services/rule-diller/internal"
+	"github.com/EbumbaE/bandit/services/rule-diller/internal/targeting"
 )
 
-var ErrEmptyAnswer = errors.New("empty variants len")
+var (
+	ErrEmptyAnswer  = errors.New("empty variants len")
+	ErrNoRule       = errors.New("no rule matched")
+	ErrUnitRequired = errors.New("unit id required for layered rule")
+	ErrNotInLayer   = errors.New("unit is assigned to another rule in layer")
+)
 
 type Storage interface {
-	GetRuleVariants(ctx context.Context, service, context string, withData bool) ([]model.Variant, error)
-	GetRuleVersion(ctx context.Context, service, context string) (uint64, error)
+	GetContextRules(ctx context.Context, service, context string) ([]model.Rule, error)
+	GetRuleVariants(ctx context.Context, service, context, ruleID string, withData bool) ([]model.Variant, error)
+	GetRuleVersion(ctx context.Context, service, context, ruleID string) (uint64, error)
 	GetVariantData(ctx context.Context, service, context, variantID string) (string, error)
-	GetVariantRule(ctx context.Context, service, context, variantID string) (string, error)
 
 	IncVariantCount(ctx context.Context, service, context, variantID string) error
+
+	GetLayerRules(ctx context.Context, layerID string) ([]string, error)
 }
 
 type Provider struct {
@@ -33,8 +43,23 @@ func NewProvider(storage Storage) *Provider {
 	}
 }
 
-func (p *Provider) GetRuleData(ctx context.Context, service, ctxKey string) (string, string, error) {
-	variants, err := p.storage.GetRuleVariants(ctx, service, ctxKey, false)
+func (p *Provider) GetRuleData(ctx context.Context, service, ctxKey, unitID string, attributes map[string]string) (string, string, error) {
+	rules, err := p.storage.GetContextRules(ctx, service, ctxKey)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "GetContextRules for service[%s], context[%s]", service, ctxKey)
+	}
+
+	rule, ok := targeting.Select(rules, attributes)
+	if !ok {
+		return "", "", ErrNoRule
+	}
+	ruleID := rule.ID
+
+	if err := p.checkLayer(ctx, rule, unitID); err != nil {
+		return "", "", err
+	}
+
+	variants, err := p.storage.GetRuleVariants(ctx, service, ctxKey, ruleID, false)
 	if err != nil {
 		return "", "", errors.Wrapf(err, "GetRuleVariants for service[%s], context[%s]", service, ctxKey)
 	}
@@ -50,7 +75,7 @@ func (p *Provider) GetRuleData(ctx context.Context, service, ctxKey string) (str
 		logger.Error("IncVariantCount", zap.String("variant_key", selectedKey), zap.Error(err))
 	}
 
-	version, err := p.storage.GetRuleVersion(ctx, service, ctxKey)
+	version, err := p.storage.GetRuleVersion(ctx, service, ctxKey, ruleID)
 	if err != nil {
 		logger.Error("GetRuleVersion", zap.String("variant_key", selectedKey), zap.Error(err))
 	}
@@ -60,11 +85,6 @@ func (p *Provider) GetRuleData(ctx context.Context, service, ctxKey string) (str
 		return "", "", errors.Wrapf(err, "GetVariantData for variant[%s]", selectedKey)
 	}
 
-	ruleID, err := p.storage.GetVariantRule(ctx, service, ctxKey, selectedKey)
-	if err != nil {
-		return "", "", errors.Wrapf(err, "GetVariantRule for variant[%s]", selectedKey)
-	}
-
 	payload, err := json.Marshal(model.PayloadAnalitic{
 		Service:     service,
 		Context:     ctxKey,
@@ -79,6 +99,41 @@ func (p *Provider) GetRuleData(ctx context.Context, service, ctxKey string) (str
 	return data, string(payload), nil
 }
 
+func (p *Provider) checkLayer(ctx context.Context, rule model.Rule, unitID string) error {
+	layerID := rule.LayerID
+	if len(layerID) == 0 {
+		return nil
+	}
+
+	if len(unitID) == 0 {
+		return ErrUnitRequired
+	}
+
+	ruleIDs, err := p.storage.GetLayerRules(ctx, layerID)
+	if err != nil {
+		return errors.Wrapf(err, "GetLayerRules for layer[%s]", layerID)
+	}
+
+	if assignRule(layerID, unitID, ruleIDs) != rule.ID {
+		return ErrNotInLayer
+	}
+
+	return nil
+}
+
+func assignRule(layerID, unitID string, ruleIDs []string) string {
+	if len(ruleIDs) == 0 {
+		return ""
+	}
+
+	sort.Strings(ruleIDs)
+
+	h := fnv.New32a()
+	h.Write([]byte(layerID + ":" + unitID))
+
+	return ruleIDs[h.Sum32()%uint32(len(ruleIDs))]
+}
+
 func convertToProperties(variants []model.Variant) map[string]bandit.Probability {
 	result := make(map[string]bandit.Probability, len(variants))
 
@@ -93,9 +148,18 @@ func convertToProperties(variants []model.Variant) map[string]bandit.Probability
 }
 
 func (p *Provider) GetRuleStatistic(ctx context.Context, service, ctxKey string) ([]model.Variant, error) {
-	variants, err := p.storage.GetRuleVariants(ctx, service, ctxKey, true)
+	rules, err := p.storage.GetContextRules(ctx, service, ctxKey)
 	if err != nil {
-		return nil, errors.Wrapf(err, "GetRuleVariants for service[%s], context[%s]", service, ctxKey)
+		return nil, errors.Wrapf(err, "GetContextRules for service[%s], context[%s]", service, ctxKey)
+	}
+
+	variants := make([]model.Variant, 0)
+	for _, r := range rules {
+		ruleVariants, err := p.storage.GetRuleVariants(ctx, service, ctxKey, r.ID, true)
+		if err != nil {
+			return nil, errors.Wrapf(err, "GetRuleVariants for service[%s], context[%s], rule[%s]", service, ctxKey, r.ID)
+		}
+		variants = append(variants, ruleVariants...)
 	}
 
 	return variants, nil
@@ -2,12 +2,16 @@ package storage
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/EbumbaE/bandit/pkg/logger"
 	redis_client "github.com/EbumbaE/bandit/pkg/redis"
 	"github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
 
 	model "github.com/EbumbaE/bandit/services/rule-diller/internal"
 )
@@ -22,22 +26,136 @@ func NewStorage(conn *redis_client.Client) *Storage {
 	}
 }
 
-func keyRuleVersion(service, context string) string {
-	return fmt.Sprintf("rule:%s:%s:version", service, context)
+func keyContextRules(service, context string) string {
+	return fmt.Sprintf("rule:%s:%s:rules", service, context)
 }
 
-func keyRuleVariants(service, context string) string {
-	return fmt.Sprintf("rule:%s:%s:variants", service, context)
+func keyRuleInfo(service, context, ruleID string) string {
+	return fmt.Sprintf("rule:%s:%s:%s:info", service, context, ruleID)
+}
+
+func keyRuleVersion(service, context, ruleID string) string {
+	return fmt.Sprintf("rule:%s:%s:%s:version", service, context, ruleID)
+}
+
+func keyRuleVariants(service, context, ruleID string) string {
+	return fmt.Sprintf("rule:%s:%s:%s:variants", service, context, ruleID)
 }
 
 func keyVariantData(service, context, variantID string) string {
 	return fmt.Sprintf("variant:%s:%s:%s", service, context, variantID)
 }
 
+func keyLayerRules(layerID string) string {
+	return fmt.Sprintf("layer:%s:rules", layerID)
+}
+
+// legacy layout kept one rule per service context, variant data keys are the same
+func keyLegacyRuleVersion(service, context string) string {
+	return fmt.Sprintf("rule:%s:%s:version", service, context)
+}
+
+func keyLegacyRuleVariants(service, context string) string {
+	return fmt.Sprintf("rule:%s:%s:variants", service, context)
+}
+
+func (s *Storage) SaveRuleInfo(ctx context.Context, rule model.Rule) error {
+	targeting, err := json.Marshal(rule.Targeting)
+	if err != nil {
+		return errors.Wrap(err, "marshal targeting")
+	}
+
+	pipe := s.conn.TxPipeline()
+
+	pipe.HSet(ctx, keyRuleInfo(rule.Service, rule.Context, rule.ID),
+		"priority", rule.Priority,
+		"targeting", string(targeting),
+		"layer_id", rule.LayerID,
+	)
+	pipe.ZAdd(ctx, keyContextRules(rule.Service, rule.Context), redis.Z{
+		Score:  float64(rule.Priority),
+		Member: rule.ID,
+	})
+
+	_, err = pipe.Exec(ctx)
+	return errors.Wrap(err, "save rule info")
+}
+
+func (s *Storage) RemoveRule(ctx context.Context, service, context, ruleID string) error {
+	variantIDs, err := s.conn.ZRange(ctx, keyRuleVariants(service, context, ruleID), 0, -1).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return errors.Wrap(err, "get current variants")
+	}
+
+	pipe := s.conn.TxPipeline()
+
+	pipe.ZRem(ctx, keyContextRules(service, context), ruleID)
+	pipe.Del(ctx, keyRuleInfo(service, context, ruleID), keyRuleVersion(service, context, ruleID), keyRuleVariants(service, context, ruleID))
+	for _, id := range variantIDs {
+		pipe.Del(ctx, keyVariantData(service, context, id))
+	}
+
+	_, err = pipe.Exec(ctx)
+	return errors.Wrap(err, "remove rule")
+}
+
+func (s *Storage) GetContextRules(ctx context.Context, service, context string) ([]model.Rule, error) {
+	ruleIDs, err := s.conn.ZRevRange(ctx, keyContextRules(service, context), 0, -1).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	pipe := s.conn.TxPipeline()
+
+	cmds := make([]*redis.MapStringStringCmd, len(ruleIDs))
+	for i, ruleID := range ruleIDs {
+		cmds[i] = pipe.HGetAll(ctx, keyRuleInfo(service, context, ruleID))
+	}
+
+	if len(ruleIDs) > 0 {
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, errors.Wrap(err, "pipeline execution failed")
+		}
+	}
+
+	rules := make([]model.Rule, 0, len(ruleIDs))
+	for i, ruleID := range ruleIDs {
+		res, err := cmds[i].Result()
+		if err != nil {
+			return nil, errors.Wrapf(err, "get info for rule %s", ruleID)
+		}
+
+		rule := model.Rule{
+			ID:      ruleID,
+			Service: service,
+			Context: context,
+			LayerID: res["layer_id"],
+		}
+
+		if p, ok := res["priority"]; ok {
+			priority, err := strconv.ParseInt(p, 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			rule.Priority = int32(priority)
+		}
+
+		if t, ok := res["targeting"]; ok && len(t) > 0 {
+			if err := json.Unmarshal([]byte(t), &rule.Targeting); err != nil {
+				return nil, errors.Wrapf(err, "unmarshal targeting for rule %s", ruleID)
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
 func (s *Storage) SaveRuleVariants(ctx context.Context, service, context, ruleID string, variants []model.Variant) error {
 	pipe := s.conn.TxPipeline()
 
-	oldVariants, err := s.conn.ZRange(ctx, keyRuleVariants(service, context), 0, -1).Result()
+	oldVariants, err := s.conn.ZRange(ctx, keyRuleVariants(service, context, ruleID), 0, -1).Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return errors.Wrap(err, "get current variants")
 	}
@@ -55,14 +173,14 @@ func (s *Storage) SaveRuleVariants(ctx context.Context, service, context, ruleID
 	}
 
 	if len(toDelete) > 0 {
-		pipe.ZRem(ctx, keyRuleVariants(service, context), toDelete)
+		pipe.ZRem(ctx, keyRuleVariants(service, context, ruleID), toDelete)
 		for _, id := range toDelete {
 			pipe.Del(ctx, keyVariantData(service, context, id))
 		}
 	}
 
 	for _, v := range variants {
-		pipe.ZAdd(ctx, keyRuleVariants(service, context), redis.Z{
+		pipe.ZAdd(ctx, keyRuleVariants(service, context, ruleID), redis.Z{
 			Score:  v.Score,
 			Member: v.Key,
 		})
@@ -74,12 +192,12 @@ func (s *Storage) SaveRuleVariants(ctx context.Context, service, context, ruleID
 	return errors.Wrap(err, "save rule variants")
 }
 
-func (s *Storage) SaveRuleVersion(ctx context.Context, service, context string, version uint64) error {
-	return s.conn.Set(ctx, keyRuleVersion(service, context), version, 0).Err()
+func (s *Storage) SaveRuleVersion(ctx context.Context, service, context, ruleID string, version uint64) error {
+	return s.conn.Set(ctx, keyRuleVersion(service, context, ruleID), version, 0).Err()
 }
 
-func (s *Storage) GetRuleVariants(ctx context.Context, service, context string, withData bool) ([]model.Variant, error) {
-	variantIDs, err := s.conn.ZRange(ctx, keyRuleVariants(service, context), 0, -1).Result()
+func (s *Storage) GetRuleVariants(ctx context.Context, service, context, ruleID string, withData bool) ([]model.Variant, error) {
+	variantIDs, err := s.conn.ZRange(ctx, keyRuleVariants(service, context, ruleID), 0, -1).Result()
 	if err != nil {
 		return nil, err
 	}
@@ -103,9 +221,8 @@ func (s *Storage) GetRuleVariants(ctx context.Context, service, context string,
 
 	for _, variantID := range variantIDs {
 		var (
-			count  uint64
-			data   string
-			ruleID string
+			count uint64
+			data  string
 		)
 
 		if withData {
@@ -125,7 +242,6 @@ func (s *Storage) GetRuleVariants(ctx context.Context, service, context string,
 				}
 			}
 			data = res["data"]
-			ruleID = res["rule_id"]
 		} else {
 			cmd, ok := cmds[variantID].(*redis.StringCmd)
 			if !ok {
@@ -137,7 +253,7 @@ func (s *Storage) GetRuleVariants(ctx context.Context, service, context string,
 			}
 		}
 
-		score, err := s.conn.ZScore(ctx, keyRuleVariants(service, context), variantID).Result()
+		score, err := s.conn.ZScore(ctx, keyRuleVariants(service, context, ruleID), variantID).Result()
 		if err != nil {
 			return nil, err
 		}
@@ -159,8 +275,8 @@ func (s *Storage) IncVariantCount(ctx context.Context, service, context, variant
 	return errors.Wrap(err, "increment variant count")
 }
 
-func (s *Storage) GetRuleVersion(ctx context.Context, service, context string) (uint64, error) {
-	v, err := s.conn.Get(ctx, keyRuleVersion(service, context)).Uint64()
+func (s *Storage) GetRuleVersion(ctx context.Context, service, context, ruleID string) (uint64, error) {
+	v, err := s.conn.Get(ctx, keyRuleVersion(service, context, ruleID)).Uint64()
 	return v, err
 }
 
@@ -168,10 +284,6 @@ func (s *Storage) GetVariantData(ctx context.Context, service, context, variantI
 	return s.conn.HGet(ctx, keyVariantData(service, context, variantID), "data").Result()
 }
 
-func (s *Storage) GetVariantRule(ctx context.Context, service, context, variantID string) (string, error) {
-	return s.conn.HGet(ctx, keyVariantData(service, context, variantID), "rule_id").Result()
-}
-
 func (s *Storage) GetVariantCount(ctx context.Context, service, context, variantID string) (uint64, error) {
 	count, err := s.conn.HGet(ctx, keyVariantData(service, context, variantID), "count").Uint64()
 	if err != nil {
@@ -179,3 +291,83 @@ func (s *Storage) GetVariantCount(ctx context.Context, service, context, variant
 	}
 	return count, nil
 }
+
+func (s *Storage) GetRuleLayer(ctx context.Context, service, context, ruleID string) (string, error) {
+	layerID, err := s.conn.HGet(ctx, keyRuleInfo(service, context, ruleID), "layer_id").Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return "", err
+	}
+	return layerID, nil
+}
+
+func (s *Storage) SaveLayerRules(ctx context.Context, layerID string, ruleIDs []string) error {
+	pipe := s.conn.TxPipeline()
+
+	pipe.Del(ctx, keyLayerRules(layerID))
+	if len(ruleIDs) > 0 {
+		members := make([]any, len(ruleIDs))
+		for i, id := range ruleIDs {
+			members[i] = id
+		}
+		pipe.SAdd(ctx, keyLayerRules(layerID), members...)
+	}
+
+	_, err := pipe.Exec(ctx)
+	return errors.Wrap(err, "save layer rules")
+}
+
+func (s *Storage) GetLayerRules(ctx context.Context, layerID string) ([]string, error) {
+	ids, err := s.conn.SMembers(ctx, keyLayerRules(layerID)).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetLegacyRules returns rules still stored in the layout without rule ids in keys.
+// The rule id is taken from the variant data, rules without variants have none.
+// Per-rule keys are told apart by their info key. Legacy keys with ':' in the
+// service or context can't be split and are logged for a manual migration.
+func (s *Storage) GetLegacyRules(ctx context.Context) ([]model.Rule, error) {
+	var rules []model.Rule
+
+	iter := s.conn.Scan(ctx, 0, "rule:*:variants", 100).Iterator()
+	for iter.Next(ctx) {
+		name := strings.TrimSuffix(strings.TrimPrefix(iter.Val(), "rule:"), ":variants")
+
+		exists, err := s.conn.Exists(ctx, "rule:"+name+":info").Result()
+		if err != nil {
+			return nil, errors.Wrapf(err, "check rule info of %s", iter.Val())
+		}
+		if exists > 0 {
+			continue
+		}
+
+		service, serviceContext, ok := strings.Cut(name, ":")
+		if !ok || strings.Contains(serviceContext, ":") {
+			logger.Error("skip legacy rule key", zap.String("key", iter.Val()))
+			continue
+		}
+		rule := model.Rule{Service: service, Context: serviceContext}
+
+		variantIDs, err := s.conn.ZRange(ctx, iter.Val(), 0, 0).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return nil, errors.Wrapf(err, "get legacy variants of %s", iter.Val())
+		}
+		if len(variantIDs) > 0 {
+			rule.ID, err = s.conn.HGet(ctx, keyVariantData(rule.Service, rule.Context, variantIDs[0]), "rule_id").Result()
+			if err != nil && !errors.Is(err, redis.Nil) {
+				return nil, errors.Wrapf(err, "get legacy rule id of %s", iter.Val())
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, errors.Wrap(iter.Err(), "scan legacy rules")
+}
+
+func (s *Storage) RemoveLegacyRule(ctx context.Context, service, context string) error {
+	err := s.conn.Del(ctx, keyLegacyRuleVersion(service, context), keyLegacyRuleVariants(service, context)).Err()
+	return errors.Wrap(err, "remove legacy rule")
+}
@@ -0,0 +1,125 @@
+package targeting
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	model "github.com/EbumbaE/bandit/services/rule-diller/internal"
+)
+
+func Select(rules []model.Rule, attributes map[string]string) (model.Rule, bool) {
+	sorted := slices.Clone(rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority > sorted[j].Priority
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	var (
+		fallback    model.Rule
+		hasFallback bool
+	)
+	for _, r := range sorted {
+		if len(r.Targeting) == 0 {
+			if !hasFallback {
+				fallback, hasFallback = r, true
+			}
+			continue
+		}
+
+		if Match(r.Targeting, attributes) {
+			return r, true
+		}
+	}
+
+	return fallback, hasFallback
+}
+
+func Match(conditions []model.TargetingCondition, attributes map[string]string) bool {
+	for _, c := range conditions {
+		if !matchCondition(c, attributes) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchCondition(c model.TargetingCondition, attributes map[string]string) bool {
+	value, ok := attributes[c.Attribute]
+	if !ok {
+		return c.Operator == model.TargetingOperatorNotIn
+	}
+
+	switch c.Operator {
+	case model.TargetingOperatorEq, model.TargetingOperatorIn:
+		return slices.Contains(c.Values, value)
+	case model.TargetingOperatorNotIn:
+		return !slices.Contains(c.Values, value)
+	case model.TargetingOperatorVersionGte:
+		cmp, ok := compareVersions(value, firstValue(c.Values))
+		return ok && cmp >= 0
+	case model.TargetingOperatorVersionLt:
+		cmp, ok := compareVersions(value, firstValue(c.Values))
+		return ok && cmp < 0
+	default:
+		return false
+	}
+}
+
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func compareVersions(a, b string) (int, bool) {
+	pa, ok := parseVersion(a)
+	if !ok {
+		return 0, false
+	}
+	pb, ok := parseVersion(b)
+	if !ok {
+		return 0, false
+	}
+
+	for i := 0; i < max(len(pa), len(pb)); i++ {
+		var x, y int
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		if x != y {
+			if x < y {
+				return -1, true
+			}
+			return 1, true
+		}
+	}
+
+	return 0, true
+}
+
+func parseVersion(v string) ([]int, bool) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if len(v) == 0 {
+		return nil, false
+	}
+
+	parts := strings.Split(v, ".")
+	res := make([]int, len(parts))
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return nil, false
+		}
+		res[i] = n
+	}
+
+	return res, true
+}
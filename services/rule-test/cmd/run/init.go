@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/EbumbaE/bandit/pkg/auth"
 	rule_admin_client "github.com/EbumbaE/bandit/pkg/genproto/rule-admin/api"
 	rule_diller_client "github.com/EbumbaE/bandit/pkg/genproto/rule-diller/api"
 	"github.com/EbumbaE/bandit/pkg/kafka"
@@ -84,7 +85,10 @@ func (a *application) initClients(ctx context.Context) {
 		a.clients.ruleDiller = wrapper.NewRuleDillerWrapper(rule_diller_client.NewRuleDillerServiceClient(conn))
 	}
 	{
-		conn, err := grpc.DialContext(ctx, a.cfg.Service.RuleAdminAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		conn, err := grpc.DialContext(ctx, a.cfg.Service.RuleAdminAddress,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithPerRPCCredentials(auth.NewAPIKeyCredentials(a.cfg.Service.RuleAdminApiKey)),
+		)
 		if err != nil {
 			logger.Fatal("connect to rule-admin", zap.Error(err))
 		}
@@ -70,6 +70,7 @@ type RuleAdminService struct {
 	SwaggerHost       string        `yaml:"swagger_host"`
 	RuleDillerAddress string        `yaml:"rule_diller_address"`
 	RuleAdminAddress  string        `yaml:"rule_admin_address"`
+	RuleAdminApiKey   string        `yaml:"rule_admin_api_key"`
 	ConnectionTimeout time.Duration `yaml:"connection_timeout"`
 }
 
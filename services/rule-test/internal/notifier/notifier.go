@@ -26,6 +26,7 @@ var (
 	ViewActionType     = ActionType("view")
 	CartActionType     = ActionType("add_to_cart")
 	PurchaseActionType = ActionType("purchase")
+	ErrorActionType    = ActionType("error")
 )
 
 type Analitic struct {